@@ -0,0 +1,43 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ppc64le-cloud/pvsadm/cmd/audit/query"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Inspect the audit log",
+	Long: `Inspect the audit log
+
+The operations modifying the resources are recorded in the file passed via --audit-file along with the IAM identity, account, workspace and the outcome.
+The entries are shipped to the file, syslog and webhook sinks instead when they are set in the config file:
+
+audit:
+  sinks:
+  - type: syslog
+    network: tcp
+    address: logs.example.com:514
+  - type: webhook
+    url: https://audit.example.com/events`,
+	GroupID: "admin",
+}
+
+func init() {
+	Cmd.AddCommand(query.Cmd)
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/audit"
+	"github.com/ppc64le-cloud/pvsadm/pkg/config"
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+var (
+	since               time.Duration
+	operation, resource string
+)
+
+var Cmd = &cobra.Command{
+	Use:   "query",
+	Short: "Query the audit log",
+	Long: `Query the audit log, the rotated audit files are read along with the --audit-file or the path of the file sink
+in the config file
+
+Examples:
+# List the operations of the last day
+pvsadm audit query --since 24h
+
+# List the deleted virtual machines
+pvsadm audit query --op delete --resource vms
+
+# List the operations on the resource
+pvsadm audit query --resource <RESOURCE_ID>
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		filter := audit.Filter{
+			Operation: operation,
+			Resource:  resource,
+		}
+		if since != 0 {
+			filter.Since = time.Now().Add(-since)
+		}
+		cfg, err := config.Load()
+		if err != nil {
+			return err
+		}
+		file, err := audit.FilePath(cfg.Audit.Sinks, pkg.Options.AuditFile)
+		if err != nil {
+			return err
+		}
+		entries, err := audit.Query(file, filter)
+		if err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			klog.Infof("No entries found in the audit file: %s", file)
+			return nil
+		}
+
+		table := utils.NewTable()
+		table.SetHeader([]string{"Timestamp", "User", "Account", "Workspace", "Resource", "Op", "ID", "Value", "Outcome"})
+		for _, e := range entries {
+			outcome := e.Outcome
+			if e.Error != "" {
+				outcome += ": " + e.Error
+			}
+			table.Append([]string{e.Timestamp.Local().Format(time.RFC3339), e.User, e.Account, e.Workspace, e.Name, e.Operation, e.ResourceID, e.Value, outcome})
+		}
+		table.Table.Render()
+		return nil
+	},
+}
+
+func init() {
+	Cmd.Flags().DurationVar(&since, "since", 0, "List the entries recorded within the duration, e.g: 24h")
+	Cmd.Flags().StringVar(&operation, "op", "", "List the entries of the operation, e.g: create, delete")
+	Cmd.Flags().StringVar(&resource, "resource", "", "List the entries of the resource type, e.g: vms, or the resource ID")
+}
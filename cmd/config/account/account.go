@@ -0,0 +1,85 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package account
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg/config"
+)
+
+var unset bool
+
+var Cmd = &cobra.Command{
+	Use:   "account [ACCOUNT_ID]",
+	Short: "Show or select the IBM Cloud account",
+	Long: `Show or select the IBM Cloud account the commands work against
+
+The selected account is stored in the config file and can be overridden with --account-id, the account of the
+credentials is used if none is selected. The accessible accounts are listed with: pvsadm get accounts
+
+Examples:
+# Show the selected account
+pvsadm config account
+
+# Select the account
+pvsadm config account 1234567890abcdef1234567890abcdef
+
+# Use the account of the credentials
+pvsadm config account --unset
+`,
+	Args: cobra.MaximumNArgs(1),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if unset && len(args) > 0 {
+			return fmt.Errorf("--unset can't be used along with the account ID")
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.Load()
+		if err != nil {
+			return err
+		}
+		if len(args) == 0 && !unset {
+			if cfg.Account == "" {
+				klog.Info("No account is selected, the account of the credentials is used")
+				return nil
+			}
+			fmt.Println(cfg.Account)
+			return nil
+		}
+
+		var account string
+		if len(args) > 0 {
+			account = args[0]
+		}
+		if err := config.Set("account", account); err != nil {
+			return err
+		}
+		if account == "" {
+			klog.Info("Account selection is cleared, the account of the credentials is used")
+		} else {
+			klog.Infof("Account %s is selected", account)
+		}
+		return nil
+	},
+}
+
+func init() {
+	Cmd.Flags().BoolVar(&unset, "unset", false, "Clear the selected account")
+}
@@ -0,0 +1,36 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ppc64le-cloud/pvsadm/cmd/config/account"
+	"github.com/ppc64le-cloud/pvsadm/cmd/config/environments"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "config",
+	Short: "Manage the pvsadm configuration",
+	Long: `Manage the pvsadm configuration
+
+The configuration is read from the file passed via --config or from ~/.config/pvsadm/config.yaml`,
+	GroupID: "admin",
+}
+
+func init() {
+	Cmd.AddCommand(account.Cmd)
+	Cmd.AddCommand(environments.Cmd)
+}
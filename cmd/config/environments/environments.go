@@ -0,0 +1,66 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package environments
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/client"
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "environments",
+	Short: "List the IBM Cloud environments",
+	Long: `List the IBM Cloud environments selectable via --env along with their endpoints
+
+The environments other than prod and test are defined in the config file, the unset endpoints default to the prod ones:
+
+environments:
+  staging:
+    iam: https://iam.staging.example.com
+    resourceController: https://resource-controller.staging.example.com
+    resourceManager: https://resource-controller.staging.example.com
+    powerVS: power-iaas.staging.example.com
+    cos: https://s3.{region}.cloud-object-storage.staging.example.com
+    globalTagging: https://tags.global-search-tagging.staging.example.com
+    accounts: https://accounts.staging.example.com
+
+Examples:
+# List the environments
+pvsadm config environments
+
+# List the private endpoints of the environments
+pvsadm config environments --private-endpoints
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		table := utils.NewTable()
+		table.SetHeader([]string{"Name", "IAM", "Resource Controller", "PowerVS", "COS"})
+		for _, name := range client.ListEnvironments() {
+			ep, err := client.GetEnvironment(name)
+			if err != nil {
+				return err
+			}
+			current := ""
+			if name == pkg.Options.Environment {
+				current = "*"
+			}
+			table.Append([]string{current + name, ep[client.TPEndpoint], ep[client.RCEndpoint], ep[client.PIEndpoint], ep[client.COSEndpoint]})
+		}
+		table.Table.Render()
+		return nil
+	},
+}
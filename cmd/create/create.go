@@ -18,6 +18,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ppc64le-cloud/pvsadm/cmd/create/port"
+	"github.com/ppc64le-cloud/pvsadm/cmd/create/workspace"
 	"github.com/ppc64le-cloud/pvsadm/pkg"
 )
 
@@ -30,9 +31,10 @@ var Cmd = &cobra.Command{
 
 func init() {
 	Cmd.AddCommand(port.Cmd)
+	Cmd.AddCommand(workspace.Cmd)
 	Cmd.PersistentFlags().StringVarP(&pkg.Options.WorkspaceID, "instance-id", "i", "", "Instance ID of the PowerVS instance")
 	Cmd.PersistentFlags().MarkDeprecated("instance-id", "instance-id is deprecated, workspace-id should be used")
-	Cmd.PersistentFlags().StringVarP(&pkg.Options.WorkspaceID, "workspace-id", "", "", "Workspace ID of the PowerVS workspace")
+	Cmd.PersistentFlags().StringVarP(&pkg.Options.WorkspaceID, "workspace-id", "", "", "Workspace ID or CRN of the PowerVS workspace")
 	Cmd.PersistentFlags().StringVarP(&pkg.Options.WorkspaceName, "instance-name", "n", "", "Instance name of the PowerVS instance")
 	Cmd.PersistentFlags().MarkDeprecated("instance-name", "instance-name is deprecated, workspace-name should be used")
 	Cmd.PersistentFlags().StringVarP(&pkg.Options.WorkspaceName, "workspace-name", "", "", "Workspace name of the PowerVS workspace")
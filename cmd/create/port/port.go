@@ -23,6 +23,7 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/audit"
 	"github.com/ppc64le-cloud/pvsadm/pkg/client"
 	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
 )
@@ -36,7 +37,7 @@ var Cmd = &cobra.Command{
 	Short: "Create PowerVS network port",
 	Long:  `Create PowerVS network port`,
 	PreRunE: func(cmd *cobra.Command, args []string) error {
-		return utils.EnsurePrerequisitesAreSet(pkg.Options.APIKey, pkg.Options.WorkspaceID, pkg.Options.WorkspaceName)
+		return client.EnsureWorkspace(&pkg.Options.WorkspaceID, &pkg.Options.WorkspaceName)
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		opt := pkg.Options
@@ -82,10 +83,14 @@ var Cmd = &cobra.Command{
 			IPAddress:   ipaddress,
 		}
 
+		entry := audit.Entry{Name: "ports", Operation: "create", Workspace: pvmclient.InstanceID, Value: netID + ":" + ipaddress}
 		port, err := pvmclient.NetworkClient.CreatePort(netID, params)
 		if err != nil {
+			audit.Record(entry, err)
 			return fmt.Errorf("failed to create a port, err: %v", err)
 		}
+		entry.ResourceID = *port.PortID
+		audit.Record(entry, nil)
 		klog.Infof("Successfully created a port, id: %s", *port.PortID)
 
 		table := utils.NewTable()
@@ -0,0 +1,126 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspace
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/audit"
+	"github.com/ppc64le-cloud/pvsadm/pkg/client"
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+var (
+	name, zone, resourceGroup string
+	noWait                    bool
+	timeout                   time.Duration
+)
+
+var Cmd = &cobra.Command{
+	Use:   "workspace",
+	Short: "Create PowerVS workspace",
+	Long: `Create PowerVS workspace
+
+Examples:
+# Create the workspace in the Default resource group and wait until it is active
+pvsadm create workspace --name upstream-core-lon04 --zone lon04
+
+# Create the workspace in the resource group and don't wait
+pvsadm create workspace --name upstream-core-dal10 --zone dal10 --resource-group ci --no-wait
+`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return utils.EnsureCredentialsAreSet()
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opt := pkg.Options
+
+		c, err := client.NewClientWithEnv(opt.APIKey, opt.Environment, opt.Debug)
+		if err != nil {
+			klog.Errorf("failed to create a session with IBM cloud, err: %v", err)
+			return err
+		}
+
+		workspaces, err := c.ListWorkspaceInstances()
+		if err != nil {
+			return err
+		}
+		for _, ws := range workspaces.Resources {
+			if ptr.Deref(ws.Name, "") == name && ptr.Deref(ws.RegionID, "") == zone {
+				return fmt.Errorf("workspace %s already exists in the %s zone with ID: %s", name, zone, ptr.Deref(ws.GUID, ""))
+			}
+		}
+
+		ws, err := c.CreateServiceInstance(name, utils.ServiceTypePowerVS, utils.PowerVSResourcePlanID, resourceGroup, zone)
+		audit.Record(WorkspaceEntry(ws, name, zone), err)
+		if err != nil {
+			return fmt.Errorf("failed to create the workspace %s, err: %v", name, err)
+		}
+		id := ptr.Deref(ws.GUID, "")
+
+		if !noWait {
+			klog.Infof("Waiting for the workspace %s to be active", name)
+			if err := WaitForActive(c, id, timeout); err != nil {
+				return err
+			}
+		}
+		klog.Infof("Workspace %s is created in the %s zone with ID: %s, CRN: %s", name, zone, id, ptr.Deref(ws.CRN, ""))
+		return nil
+	},
+}
+
+func init() {
+	Cmd.Flags().StringVar(&name, "name", "", "Name of the workspace")
+	Cmd.Flags().StringVar(&zone, "zone", "", "Zone of the workspace, e.g: lon04, dal10")
+	Cmd.Flags().StringVar(&resourceGroup, "resource-group", "Default", "Resource group of the workspace")
+	Cmd.Flags().BoolVar(&noWait, "no-wait", false, "Don't wait for the workspace to be active")
+	Cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Minute, "Timeout for the workspace to be active")
+	_ = Cmd.MarkFlagRequired("name")
+	_ = Cmd.MarkFlagRequired("zone")
+}
+
+// WaitForActive waits for the provisioned workspace to be active
+func WaitForActive(c *client.Client, id string, timeout time.Duration) error {
+	return utils.SpinnerPollUntil(time.NewTicker(15*time.Second).C, time.After(timeout), func() (string, bool, error) {
+		ws, err := c.GetServiceInstance(id)
+		if err != nil {
+			return "", false, err
+		}
+		state := ptr.Deref(ws.State, "")
+		switch state {
+		case "active":
+			return state, true, nil
+		case "failed", "removed":
+			return state, false, fmt.Errorf("workspace %s is in the %s state", id, state)
+		}
+		return fmt.Sprintf("Workspace is being provisioned, current state: %s", state), false, nil
+	})
+}
+
+// WorkspaceEntry returns the audit entry of the workspace creation, ws is nil when the creation failed
+func WorkspaceEntry(ws *resourcecontrollerv2.ResourceInstance, name, zone string) audit.Entry {
+	e := audit.Entry{Name: "workspace", Operation: "create", Value: name + ":" + zone}
+	if ws != nil {
+		e.Workspace = ptr.Deref(ws.GUID, "")
+		e.ResourceID = e.Workspace
+	}
+	return e
+}
@@ -0,0 +1,216 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspace
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/audit"
+	"github.com/ppc64le-cloud/pvsadm/pkg/client"
+	"github.com/ppc64le-cloud/pvsadm/pkg/inventory"
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+var (
+	purge   bool
+	timeout time.Duration
+)
+
+var Cmd = &cobra.Command{
+	Use:   "workspace",
+	Short: "Delete PowerVS workspace",
+	Long: `Delete PowerVS workspace
+
+The resources contained in the workspace are summarized before the delete, the resources are reclaimed along with the
+workspace by the IBM Cloud. Use the --purge option to delete the instances, DHCP servers, volumes, networks and images
+first and wait for the instances to be removed.
+
+Examples:
+# Delete the workspace
+pvsadm delete workspace --workspace-name upstream-core-lon04
+
+# Delete the resources of the workspace first and then the workspace without asking any confirmation
+pvsadm delete workspace --workspace-name upstream-core-lon04 --purge --no-prompt
+`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return client.EnsureWorkspace(&pkg.Options.WorkspaceID, &pkg.Options.WorkspaceName)
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opt := pkg.Options
+
+		c, err := client.NewClientWithEnv(opt.APIKey, opt.Environment, opt.Debug)
+		if err != nil {
+			klog.Errorf("failed to create a session with IBM cloud, err: %v", err)
+			return err
+		}
+
+		pvmclient, err := client.NewPVMClientWithEnv(c, opt.WorkspaceID, opt.WorkspaceName, opt.Environment)
+		if err != nil {
+			return err
+		}
+
+		counts, err := inventory.Summarize(pvmclient)
+		if err != nil {
+			return err
+		}
+		klog.Infof("Workspace %s(%s) in the %s zone contains %d resources", pvmclient.InstanceName, pvmclient.InstanceID, pvmclient.Zone, inventory.Total(counts))
+		t := utils.NewTable()
+		t.SetHeader([]string{"Resource", "Count"})
+		for _, count := range counts {
+			t.Append([]string{count.Kind, strconv.Itoa(count.Count)})
+		}
+		t.Table.Render()
+
+		if !opt.NoPrompt && !utils.AskConfirmation(fmt.Sprintf(utils.DeletePromptMessage, "resources along with the workspace "+pvmclient.InstanceName)) {
+			return nil
+		}
+
+		if purge && inventory.Total(counts) > 0 {
+			if err := Purge(pvmclient, timeout); err != nil {
+				return fmt.Errorf("failed to purge the resources of the workspace %s, err: %v", pvmclient.InstanceName, err)
+			}
+		}
+
+		klog.Infof("Deleting the workspace: %s with ID: %s", pvmclient.InstanceName, pvmclient.InstanceID)
+		err = c.DeleteServiceInstance(pvmclient.InstanceID, true)
+		audit.Record(audit.Entry{Name: "workspace", Operation: "delete", Workspace: pvmclient.InstanceID, ResourceID: pvmclient.InstanceID, Value: pvmclient.InstanceName + ":" + pvmclient.Zone}, err)
+		if err != nil {
+			return fmt.Errorf("failed to delete the workspace %s, err: %v", pvmclient.InstanceName, err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	Cmd.Flags().BoolVar(&purge, "purge", false, "Delete the resources of the workspace before deleting the workspace")
+	Cmd.Flags().BoolVar(&pkg.Options.NoPrompt, "no-prompt", false, "Show prompt before doing any destructive operations")
+	Cmd.Flags().DurationVar(&timeout, "timeout", 30*time.Minute, "Timeout for the instances and the DHCP servers to be removed with the --purge option")
+}
+
+// Purge deletes the resources of the workspace, the instances are deleted first and waited for the removal to
+// release the volumes and the ports, then the DHCP servers are deleted and waited for the removal to release their
+// networks
+func Purge(pvmclient *client.PVMClient, timeout time.Duration) error {
+	ws := pvmclient.InstanceName
+
+	instances, err := pvmclient.InstanceClient.GetAll()
+	if err != nil {
+		return err
+	}
+	for _, instance := range instances.PvmInstances {
+		klog.Infof("Deleting instance: %s with ID: %s", *instance.ServerName, *instance.PvmInstanceID)
+		err = pvmclient.InstanceClient.Delete(*instance.PvmInstanceID)
+		audit.Record(audit.Entry{Name: "vms", Operation: "delete", Workspace: pvmclient.InstanceID, ResourceID: *instance.PvmInstanceID, Value: ws + ":" + *instance.ServerName}, err)
+		if err != nil {
+			return err
+		}
+	}
+	if len(instances.PvmInstances) > 0 {
+		err := utils.SpinnerPollUntil(time.NewTicker(15*time.Second).C, time.After(timeout), func() (string, bool, error) {
+			instances, err := pvmclient.InstanceClient.GetAll()
+			if err != nil {
+				return "", false, err
+			}
+			return fmt.Sprintf("Waiting for %d instances to be removed", len(instances.PvmInstances)), len(instances.PvmInstances) == 0, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	servers, err := pvmclient.DHCPClient.GetAll()
+	if err != nil {
+		return err
+	}
+	for _, server := range servers {
+		klog.Infof("Deleting DHCP server with ID: %s", *server.ID)
+		err = pvmclient.DHCPClient.Delete(*server.ID)
+		audit.Record(audit.Entry{Name: "dhcpserver", Operation: "delete", Workspace: pvmclient.InstanceID, ResourceID: *server.ID, Value: ws + ":" + *server.ID}, err)
+		if err != nil {
+			return err
+		}
+	}
+	// The DHCP servers are deleted asynchronously and their networks can't be deleted until they are gone
+	if len(servers) > 0 {
+		err := utils.SpinnerPollUntil(time.NewTicker(15*time.Second).C, time.After(timeout), func() (string, bool, error) {
+			servers, err := pvmclient.DHCPClient.GetAll()
+			if err != nil {
+				return "", false, err
+			}
+			return fmt.Sprintf("Waiting for %d DHCP servers to be removed", len(servers)), len(servers) == 0, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	volumes, err := pvmclient.VolumeClient.GetAll()
+	if err != nil {
+		return err
+	}
+	for _, volume := range volumes.Volumes {
+		klog.Infof("Deleting volume: %s with ID: %s", *volume.Name, *volume.VolumeID)
+		err = pvmclient.VolumeClient.DeleteVolume(*volume.VolumeID)
+		audit.Record(audit.Entry{Name: "volumes", Operation: "delete", Workspace: pvmclient.InstanceID, ResourceID: *volume.VolumeID, Value: ws + ":" + *volume.Name}, err)
+		if err != nil {
+			return err
+		}
+	}
+
+	networks, err := pvmclient.NetworkClient.GetAll()
+	if err != nil {
+		return err
+	}
+	for _, network := range networks.Networks {
+		ports, err := pvmclient.NetworkClient.GetAllPorts(*network.NetworkID)
+		if err != nil {
+			return err
+		}
+		for _, port := range ports.Ports {
+			klog.Infof("Deleting port: %s of the network: %s", *port.PortID, *network.Name)
+			err = pvmclient.NetworkClient.DeletePort(*network.NetworkID, *port.PortID)
+			audit.Record(audit.Entry{Name: "ports", Operation: "delete", Workspace: pvmclient.InstanceID, ResourceID: *port.PortID, Value: ws + ":" + *network.Name}, err)
+			if err != nil {
+				return err
+			}
+		}
+		klog.Infof("Deleting network: %s with ID: %s", *network.Name, *network.NetworkID)
+		err = pvmclient.NetworkClient.Delete(*network.NetworkID)
+		audit.Record(audit.Entry{Name: "networks", Operation: "delete", Workspace: pvmclient.InstanceID, ResourceID: *network.NetworkID, Value: ws + ":" + *network.Name}, err)
+		if err != nil {
+			return err
+		}
+	}
+
+	images, err := pvmclient.ImgClient.GetAll()
+	if err != nil {
+		return err
+	}
+	for _, image := range images.Images {
+		klog.Infof("Deleting image: %s with ID: %s", *image.Name, *image.ImageID)
+		err = pvmclient.ImgClient.Delete(*image.ImageID)
+		audit.Record(audit.Entry{Name: "images", Operation: "delete", Workspace: pvmclient.InstanceID, ResourceID: *image.ImageID, Value: ws + ":" + *image.Name}, err)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspace
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/client"
+	"github.com/ppc64le-cloud/pvsadm/pkg/inventory"
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "workspace",
+	Short: "Describe PowerVS workspace",
+	Long: `Describe PowerVS workspace
+
+Shows the details of the workspace, the capabilities and the storage tiers of the zone and the number of the resources
+in the workspace.
+
+Examples:
+# Describe the workspace
+pvsadm describe workspace --workspace-name upstream-core-lon04
+`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return client.EnsureWorkspace(&pkg.Options.WorkspaceID, &pkg.Options.WorkspaceName)
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opt := pkg.Options
+
+		c, err := client.NewClientWithEnv(opt.APIKey, opt.Environment, opt.Debug)
+		if err != nil {
+			klog.Errorf("failed to create a session with IBM cloud, err: %v", err)
+			return err
+		}
+
+		pvmclient, err := client.NewPVMClientWithEnv(c, opt.WorkspaceID, opt.WorkspaceName, opt.Environment)
+		if err != nil {
+			return err
+		}
+
+		ws, err := c.GetServiceInstance(pvmclient.InstanceID)
+		if err != nil {
+			return err
+		}
+		groups, err := c.ListResourceGroups()
+		if err != nil {
+			klog.Warningf("Resource group ID is shown instead of the name, err: %v", err)
+		}
+		dc, err := pvmclient.DatacenterClient.Get(pvmclient.Zone)
+		if err != nil {
+			return fmt.Errorf("failed to get the datacenter %s, err: %v", pvmclient.Zone, err)
+		}
+		tiers, err := pvmclient.StorageTierClient.GetAll()
+		if err != nil {
+			return fmt.Errorf("failed to get the storage tiers, err: %v", err)
+		}
+		counts, err := inventory.Summarize(pvmclient)
+		if err != nil {
+			return err
+		}
+
+		t := utils.NewTable()
+		t.SetHeader([]string{"Field", "Value"})
+		for _, row := range describe(ws, groups, dc, tiers, counts) {
+			t.Append(row)
+		}
+		t.Table.Render()
+		return nil
+	},
+}
+
+// describe returns the field and the value rows of the workspace
+func describe(ws *resourcecontrollerv2.ResourceInstance, groups map[string]string, dc *models.Datacenter, tiers models.RegionStorageTiers, counts []inventory.Count) [][]string {
+	group := ptr.Deref(ws.ResourceGroupID, "")
+	if name, ok := groups[group]; ok {
+		group = name
+	}
+	var created string
+	if ws.CreatedAt != nil {
+		created = time.Time(*ws.CreatedAt).UTC().Format(time.RFC3339)
+	}
+	rows := [][]string{
+		{"Name", ptr.Deref(ws.Name, "")},
+		{"ID", ptr.Deref(ws.GUID, "")},
+		{"CRN", ptr.Deref(ws.CRN, "")},
+		{"Zone", ptr.Deref(ws.RegionID, "")},
+		{"Resource Group", group},
+		{"State", ptr.Deref(ws.State, "")},
+		{"Created", created},
+	}
+
+	if dc.Location != nil {
+		rows = append(rows, []string{"Region", ptr.Deref(dc.Location.RegionDisplayName, "")})
+	}
+	rows = append(rows,
+		[]string{"Datacenter Status", ptr.Deref(dc.Status, "")},
+		[]string{"Datacenter Type", ptr.Deref(dc.Type, "")},
+	)
+	var enabled, disabled []string
+	for capability, ok := range dc.Capabilities {
+		if ok {
+			enabled = append(enabled, capability)
+		} else {
+			disabled = append(disabled, capability)
+		}
+	}
+	sort.Strings(enabled)
+	sort.Strings(disabled)
+	rows = append(rows,
+		[]string{"Capabilities", strings.Join(enabled, "\n")},
+		[]string{"Unavailable Capabilities", strings.Join(disabled, "\n")},
+	)
+	if details := dc.CapabilitiesDetails; details != nil {
+		if s := details.SupportedSystems; s != nil {
+			rows = append(rows,
+				[]string{"General Systems", strings.Join(s.General, ", ")},
+				[]string{"Dedicated Systems", strings.Join(s.Dedicated, ", ")},
+			)
+		}
+		if dr := details.DisasterRecovery; dr != nil && dr.AsynchronousReplication != nil {
+			var targets []string
+			for _, l := range dr.AsynchronousReplication.TargetLocations {
+				targets = append(targets, l.Region+"("+l.Status+")")
+			}
+			rows = append(rows, []string{"Replication Targets", strings.Join(targets, ", ")})
+		}
+	}
+
+	var storage []string
+	for _, tier := range tiers {
+		storage = append(storage, tier.Name+"("+ptr.Deref(tier.State, "")+")")
+	}
+	sort.Strings(storage)
+	rows = append(rows, []string{"Storage Tiers", strings.Join(storage, ", ")})
+
+	var resources []string
+	for _, count := range counts {
+		resources = append(resources, count.Kind+": "+strconv.Itoa(count.Count))
+	}
+	rows = append(rows, []string{"Resources", strings.Join(resources, "\n")})
+	return rows
+}
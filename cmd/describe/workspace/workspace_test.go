@@ -0,0 +1,71 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspace
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"k8s.io/utils/ptr"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg/inventory"
+)
+
+func Test_describe(t *testing.T) {
+	ws := &resourcecontrollerv2.ResourceInstance{
+		Name: ptr.To("ws"), GUID: ptr.To("ws-id"), RegionID: ptr.To("lon04"), ResourceGroupID: ptr.To("rg-1"), State: ptr.To("active"),
+	}
+	dc := &models.Datacenter{
+		Status:       ptr.To("active"),
+		Type:         ptr.To("off-premises"),
+		Location:     &models.DatacenterLocation{RegionDisplayName: ptr.To("London")},
+		Capabilities: map[string]bool{"vpn-connections": true, "cloud-connections": false, "power-edge-router": true},
+		CapabilitiesDetails: &models.CapabilitiesDetails{
+			SupportedSystems: &models.SupportedSystems{General: []string{"s922", "e980"}},
+			DisasterRecovery: &models.DisasterRecovery{AsynchronousReplication: &models.ReplicationService{
+				TargetLocations: []*models.ReplicationTargetLocation{{Region: "mad02", Status: "active"}},
+			}},
+		},
+	}
+	tiers := models.RegionStorageTiers{{Name: "tier3", State: ptr.To("active")}, {Name: "tier1", State: ptr.To("inactive")}}
+	counts := []inventory.Count{{Kind: inventory.KindInstance, Count: 2}, {Kind: inventory.KindVolume, Count: 0}}
+
+	rows := map[string]string{}
+	for _, row := range describe(ws, map[string]string{"rg-1": "default"}, dc, tiers, counts) {
+		rows[row[0]] = row[1]
+	}
+	want := map[string]string{
+		"Resource Group":           "default",
+		"Region":                   "London",
+		"Capabilities":             "power-edge-router\nvpn-connections",
+		"Unavailable Capabilities": "cloud-connections",
+		"General Systems":          "s922, e980",
+		"Replication Targets":      "mad02(active)",
+		"Storage Tiers":            "tier1(inactive), tier3(active)",
+		"Resources":                "instance: 2\nvolume: 0",
+	}
+	for field, value := range want {
+		if rows[field] != value {
+			t.Errorf("describe() %s = %q, want %q", field, rows[field], value)
+		}
+	}
+
+	got := describe(ws, nil, &models.Datacenter{}, nil, nil)
+	if !reflect.DeepEqual(got[4], []string{"Resource Group", "rg-1"}) {
+		t.Errorf("describe() without the resource group names = %v, want the ID", got[4])
+	}
+}
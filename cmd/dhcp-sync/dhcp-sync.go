@@ -210,7 +210,7 @@ var Cmd = &cobra.Command{
 func init() {
 	Cmd.Flags().StringVarP(&pkg.Options.WorkspaceID, "instance-id", "i", "", "Instance ID of the PowerVS instance")
 	Cmd.Flags().MarkDeprecated("instance-id", "instance-id is deprecated, workspace-id should be used")
-	Cmd.Flags().StringVarP(&pkg.Options.WorkspaceID, "workspace-id", "w", "", "Workspace ID of the PowerVS instance")
+	Cmd.Flags().StringVarP(&pkg.Options.WorkspaceID, "workspace-id", "w", "", "Workspace ID or CRN of the PowerVS instance")
 	Cmd.Flags().StringVar(&networkID, "network-id", "", "Network ID to be monitored")
 	Cmd.Flags().StringVar(&file, "file", "/etc/dhcp/dhcpd.conf", "DHCP conf file")
 	Cmd.Flags().StringVar(&gateway, "gateway", "", "Override the gateway value with")
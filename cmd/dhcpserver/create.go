@@ -21,9 +21,11 @@ import (
 
 	"github.com/spf13/cobra"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/audit"
 	"github.com/ppc64le-cloud/pvsadm/pkg/client"
 )
 
@@ -65,10 +67,14 @@ var createCmd = &cobra.Command{
 		if name != "" {
 			body.Name = core.StringPtr(name)
 		}
-		_, err = pvmclient.DHCPClient.Create(body)
+		entry := audit.Entry{Name: "dhcpserver", Operation: "create", Workspace: pvmclient.InstanceID, Value: name}
+		server, err := pvmclient.DHCPClient.Create(body)
 		if err != nil {
+			audit.Record(entry, err)
 			return fmt.Errorf("failed to create a dhcpserver, err: %v", err)
 		}
+		entry.ResourceID = ptr.Deref(server.ID, "")
+		audit.Record(entry, nil)
 
 		klog.Info("Successfully created a DHCP server")
 		return nil
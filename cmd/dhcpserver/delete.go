@@ -21,6 +21,7 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/audit"
 	"github.com/ppc64le-cloud/pvsadm/pkg/client"
 )
 
@@ -44,6 +45,7 @@ var deleteCmd = &cobra.Command{
 		}
 
 		err = pvmclient.DHCPClient.Delete(dhcp)
+		audit.Record(audit.Entry{Name: "dhcpserver", Operation: "delete", Workspace: pvmclient.InstanceID, ResourceID: dhcp}, err)
 		if err != nil {
 			return fmt.Errorf("failed to delete a dhcpserver, err: %v", err)
 		}
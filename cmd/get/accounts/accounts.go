@@ -0,0 +1,69 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accounts
+
+import (
+	"sort"
+
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/client"
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "accounts",
+	Short: "List the IBM Cloud accounts accessible with the credentials",
+	Long: `List the IBM Cloud accounts accessible with the credentials, the account the commands work against is marked with *
+
+Examples:
+# List the accounts
+pvsadm get accounts
+
+# Work against one of the accounts
+pvsadm config account <ACCOUNT_ID>
+`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return utils.EnsureCredentialsAreSet()
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opt := pkg.Options
+		c, err := client.NewClientWithEnv(opt.APIKey, opt.Environment, opt.Debug)
+		if err != nil {
+			klog.Errorf("failed to create a session with IBM cloud: %v", err)
+			return err
+		}
+
+		accounts, err := c.ListAccounts()
+		if err != nil {
+			return err
+		}
+		sort.Slice(accounts, func(i, j int) bool { return accounts[i].Name < accounts[j].Name })
+
+		table := utils.NewTable()
+		table.SetHeader([]string{"ID", "Name", "State"})
+		for _, account := range accounts {
+			id := account.ID
+			if id == c.User.Account {
+				id = "*" + id
+			}
+			table.Append([]string{id, account.Name, account.State})
+		}
+		table.Table.Render()
+		return nil
+	},
+}
@@ -17,8 +17,6 @@ package cloudconnections
 import (
 	"strings"
 
-	"github.com/IBM-Cloud/power-go-client/ibmpisession"
-	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/spf13/cobra"
 	"k8s.io/klog/v2"
 
@@ -53,10 +51,6 @@ var Cmd = &cobra.Command{
 			return err
 		}
 
-		environment, err := client.GetEnvironment(opt.Environment)
-		if err != nil {
-			return err
-		}
 		// Retrieve all workspaces that are available in the account.
 		workspaceInstances, err := c.ListWorkspaceInstances()
 		if err != nil {
@@ -69,18 +63,10 @@ var Cmd = &cobra.Command{
 			zoneWorkspaces[*workspaceInstance.RegionID] = append(zoneWorkspaces[*workspaceInstance.RegionID], workspaceDetails{name: *workspaceInstance.Name, guid: *workspaceInstance.GUID})
 		}
 		cloudConnections := map[string]cloudConnectionDetails{}
-		authenticator := &core.IamAuthenticator{ApiKey: pkg.Options.APIKey, URL: environment[client.TPEndpoint]}
 		klog.Info("Listing cloud connections across all workspaces, please wait..")
 		// Create a IBM PI Session per zone and reuse them across the workspaces in the same zone.
 		for workspaceZone, workspaces := range zoneWorkspaces {
-			pvmclientOptions := ibmpisession.IBMPIOptions{
-				Authenticator: authenticator,
-				Debug:         pkg.Options.Debug,
-				URL:           environment[client.PIEndpoint],
-				UserAccount:   c.User.Account,
-				Zone:          workspaceZone,
-			}
-			piSession, err := ibmpisession.NewIBMPISession(&pvmclientOptions)
+			piSession, err := c.PISession(workspaceZone)
 			if err != nil {
 				return err
 			}
@@ -15,8 +15,11 @@
 package events
 
 import (
+	"fmt"
+	"os"
 	"time"
 
+	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/spf13/cobra"
 	"k8s.io/klog/v2"
 
@@ -26,15 +29,37 @@ import (
 )
 
 var (
-	since time.Duration
+	since        time.Duration
+	watch        bool
+	interval     time.Duration
+	output       string
+	webhook      string
+	eventsFilter filter
 )
 
 var Cmd = &cobra.Command{
 	Use:   "events",
 	Short: "Get Powervs events",
-	Long:  `Get the PowerVS events`,
+	Long: `Get the PowerVS events
+
+Examples:
+# Get the events of the last 24 hours
+pvsadm get events --workspace-name upstream-core-lon04
+
+# Watch the new error and warning events of the instances as JSON lines
+pvsadm get events --workspace-name upstream-core-lon04 --watch --resource pvm-instance --level error,warning -o json
+
+# Watch the events and forward them to the webhook
+pvsadm get events --workspace-name upstream-core-lon04 --watch --webhook https://example.com/hooks/powervs
+`,
 	PreRunE: func(cmd *cobra.Command, args []string) error {
-		return utils.EnsurePrerequisitesAreSet(pkg.Options.APIKey, pkg.Options.WorkspaceID, pkg.Options.WorkspaceName)
+		if !utils.Contains([]string{"table", "json"}, output) {
+			return fmt.Errorf("unsupported output format %q, supported: table, json", output)
+		}
+		if interval <= 0 {
+			return fmt.Errorf("--interval must be greater than 0")
+		}
+		return client.EnsureWorkspace(&pkg.Options.WorkspaceID, &pkg.Options.WorkspaceName)
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		opt := pkg.Options
@@ -49,17 +74,64 @@ var Cmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		events, err := pvmclient.EventsClient.GetPcloudEventsGetsince(since)
-		if err != nil {
-			return err
+		if !watch {
+			events, err := pvmclient.EventsClient.GetPcloudEventsGetsince(since)
+			if err != nil {
+				return err
+			}
+			return emit(eventsFilter.apply(events.Payload.Events), true)
 		}
-		table := utils.NewTable()
-		table.Render(events.Payload.Events, []string{"user", "timestamp"})
 
-		return nil
+		klog.Infof("Watching the events of the workspace %s, press Ctrl+C to stop", pvmclient.InstanceName)
+		t := newTracker(since + interval)
+		from := time.Now().Add(-since)
+		for {
+			start := time.Now()
+			events, err := pvmclient.EventsClient.GetPcloudEventsGetsince(time.Since(from))
+			if err != nil {
+				klog.Warningf("failed to get the events, err: %v", err)
+			} else if err := t.forward(eventsFilter.apply(events.Payload.Events), func(events []*models.Event) error {
+				return emit(events, false)
+			}); err != nil {
+				klog.Warningf("failed to forward the events, retrying on the next poll, err: %v", err)
+			} else {
+				// The next window overlaps with this poll to not miss the events recorded late, the duplicates are
+				// dropped by the tracker. The window isn't moved on the failures to get the missed events again.
+				from = start.Add(-interval)
+			}
+			time.Sleep(interval)
+		}
 	},
 }
 
 func init() {
 	Cmd.PersistentFlags().DurationVar(&since, "since", 24*time.Hour, "Show events since")
+	Cmd.Flags().BoolVarP(&watch, "watch", "w", false, "Watch for the new events")
+	Cmd.Flags().DurationVar(&interval, "interval", 30*time.Second, "Interval between the polls while watching the events")
+	Cmd.Flags().StringVarP(&output, "output", "o", "table", "Output format, supported: table, json(JSON lines)")
+	Cmd.Flags().StringVar(&webhook, "webhook", "", "Forward the events as JSON lines to the webhook URL instead of the stdout")
+	Cmd.Flags().StringSliceVar(&eventsFilter.resources, "resource", nil, "Show the events of the resource types, e.g: pvm-instance, image, volume")
+	Cmd.Flags().StringSliceVar(&eventsFilter.actions, "action", nil, "Show the events of the actions, e.g: create, delete")
+	Cmd.Flags().StringSliceVar(&eventsFilter.users, "user", nil, "Show the events caused by the users, matches the user ID, name or email")
+	Cmd.Flags().StringSliceVar(&eventsFilter.levels, "level", nil, "Show the events of the levels, supported: notice, info, warning, error")
+}
+
+// emit writes the events to the webhook or the stdout in the output format, the table is rendered for no events only
+// if showEmpty is set
+func emit(events []*models.Event, showEmpty bool) error {
+	if webhook != "" {
+		if len(events) == 0 {
+			return nil
+		}
+		return postEvents(webhook, events)
+	}
+	if output == "json" {
+		return writeJSONLines(os.Stdout, events)
+	}
+	if len(events) == 0 && !showEmpty {
+		return nil
+	}
+	table := utils.NewTable()
+	table.Render(events, []string{"user", "timestamp"})
+	return nil
 }
@@ -0,0 +1,167 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+// filter selects the events by the resource type, action, user and level, empty fields match all the events
+type filter struct {
+	resources, actions, users, levels []string
+}
+
+func containsFold(list []string, s string) bool {
+	for _, l := range list {
+		if strings.EqualFold(l, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func (f filter) match(e *models.Event) bool {
+	if len(f.resources) != 0 && !containsFold(f.resources, deref(e.Resource)) {
+		return false
+	}
+	if len(f.actions) != 0 && !containsFold(f.actions, deref(e.Action)) {
+		return false
+	}
+	if len(f.levels) != 0 && !containsFold(f.levels, deref(e.Level)) {
+		return false
+	}
+	if len(f.users) != 0 {
+		if e.User == nil {
+			return false
+		}
+		if !containsFold(f.users, deref(e.User.UserID)) && !containsFold(f.users, e.User.Name) && !containsFold(f.users, e.User.Email) {
+			return false
+		}
+	}
+	return true
+}
+
+// apply returns the events matching the filter
+func (f filter) apply(events []*models.Event) []*models.Event {
+	var matched []*models.Event
+	for _, e := range events {
+		if f.match(e) {
+			matched = append(matched, e)
+		}
+	}
+	return matched
+}
+
+// tracker remembers the forwarded events for the retention period to drop the duplicates across the polls
+type tracker struct {
+	seen      map[string]time.Time
+	retention time.Duration
+	now       func() time.Time
+}
+
+func newTracker(retention time.Duration) *tracker {
+	return &tracker{seen: map[string]time.Time{}, retention: retention, now: time.Now}
+}
+
+// pending returns the events not seen before sorted by the time
+func (t *tracker) pending(events []*models.Event) []*models.Event {
+	var pending []*models.Event
+	for _, e := range events {
+		if _, ok := t.seen[deref(e.EventID)]; !ok {
+			pending = append(pending, e)
+		}
+	}
+	sort.SliceStable(pending, func(i, j int) bool { return timestamp(pending[i]) < timestamp(pending[j]) })
+	return pending
+}
+
+// markSeen remembers the events and forgets the ones seen before the retention period
+func (t *tracker) markSeen(events []*models.Event) {
+	now := t.now()
+	for id, seen := range t.seen {
+		if now.Sub(seen) > t.retention {
+			delete(t.seen, id)
+		}
+	}
+	for _, e := range events {
+		t.seen[deref(e.EventID)] = now
+	}
+}
+
+// forward emits the events not seen before and marks them seen only once emitted, so the events failed to be emitted
+// are retried on the next poll
+func (t *tracker) forward(events []*models.Event, emit func([]*models.Event) error) error {
+	pending := t.pending(events)
+	if err := emit(pending); err != nil {
+		return err
+	}
+	t.markSeen(pending)
+	return nil
+}
+
+func timestamp(e *models.Event) int64 {
+	if e.Timestamp == nil {
+		return 0
+	}
+	return *e.Timestamp
+}
+
+// writeJSONLines writes the events as JSON lines
+func writeJSONLines(w io.Writer, events []*models.Event) error {
+	enc := json.NewEncoder(w)
+	for _, e := range events {
+		if err := enc.Encode(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// webhookPoster posts the events to the webhook, retrying the failed requests
+var webhookPoster = &utils.Poster{
+	Client:  &http.Client{Timeout: 30 * time.Second},
+	Retries: 3,
+	Backoff: time.Second,
+}
+
+// postEvents posts the events as JSON lines to the webhook
+func postEvents(url string, events []*models.Event) error {
+	var body bytes.Buffer
+	if err := writeJSONLines(&body, events); err != nil {
+		return err
+	}
+	if err := webhookPoster.Post(url, "application/x-ndjson", body.Bytes()); err != nil {
+		return fmt.Errorf("failed to post %d events to the webhook %s, err: %v", len(events), url, err)
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"k8s.io/utils/ptr"
+)
+
+func event(id, resource, action, level, user string, ts int64) *models.Event {
+	return &models.Event{
+		EventID:   ptr.To(id),
+		Resource:  ptr.To(resource),
+		Action:    ptr.To(action),
+		Level:     ptr.To(level),
+		Message:   ptr.To("message " + id),
+		Timestamp: ptr.To(ts),
+		User:      &models.EventUser{UserID: ptr.To(user), Email: user + "@example.com"},
+	}
+}
+
+func ids(events []*models.Event) []string {
+	var got []string
+	for _, e := range events {
+		got = append(got, *e.EventID)
+	}
+	return got
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func Test_filter(t *testing.T) {
+	events := []*models.Event{
+		event("1", "pvm-instance", "create", "info", "alice", 1),
+		event("2", "image", "delete", "error", "bob", 2),
+		event("3", "pvm-instance", "delete", "warning", "bob", 3),
+	}
+	tests := []struct {
+		name   string
+		filter filter
+		want   []string
+	}{
+		{"no filter", filter{}, []string{"1", "2", "3"}},
+		{"resource", filter{resources: []string{"PVM-Instance"}}, []string{"1", "3"}},
+		{"action and level", filter{actions: []string{"delete"}, levels: []string{"error"}}, []string{"2"}},
+		{"user email", filter{users: []string{"alice@example.com"}}, []string{"1"}},
+		{"no match", filter{levels: []string{"notice"}}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ids(tt.filter.apply(events)); !equal(got, tt.want) {
+				t.Errorf("apply() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_tracker(t *testing.T) {
+	now := time.Now()
+	tr := newTracker(time.Minute)
+	tr.now = func() time.Time { return now }
+
+	pending := tr.pending([]*models.Event{event("2", "image", "create", "info", "a", 2), event("1", "image", "create", "info", "a", 1)})
+	if got := ids(pending); !equal(got, []string{"1", "2"}) {
+		t.Errorf("pending() = %v, want the events sorted by the time", got)
+	}
+	tr.markSeen(pending)
+	if got := ids(tr.pending([]*models.Event{event("2", "image", "create", "info", "a", 2), event("3", "image", "create", "info", "a", 3)})); !equal(got, []string{"3"}) {
+		t.Errorf("pending() = %v, want only the new events", got)
+	}
+	now = now.Add(2 * time.Minute)
+	tr.markSeen([]*models.Event{event("3", "image", "create", "info", "a", 3)})
+	if got := ids(tr.pending([]*models.Event{event("1", "image", "create", "info", "a", 1)})); !equal(got, []string{"1"}) {
+		t.Errorf("pending() = %v, want the event forgotten after the retention", got)
+	}
+}
+
+func Test_postEvents(t *testing.T) {
+	retries := webhookPoster.Retries
+	webhookPoster.Retries = 0
+	t.Cleanup(func() { webhookPoster.Retries = retries })
+
+	var got []string
+	var contentType string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		scanner := bufio.NewScanner(r.Body)
+		for scanner.Scan() {
+			var e models.Event
+			if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+				t.Errorf("invalid JSON line %s, err: %v", scanner.Text(), err)
+			}
+			got = append(got, *e.EventID)
+		}
+	}))
+	defer server.Close()
+
+	if err := postEvents(server.URL, []*models.Event{event("1", "image", "create", "info", "a", 1), event("2", "image", "delete", "info", "a", 2)}); err != nil {
+		t.Fatalf("postEvents() error = %v", err)
+	}
+	if !equal(got, []string{"1", "2"}) || contentType != "application/x-ndjson" {
+		t.Errorf("postEvents() posted %v with %s", got, contentType)
+	}
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	if err := postEvents(failing.URL, []*models.Event{event("1", "image", "create", "info", "a", 1)}); err == nil {
+		t.Errorf("postEvents() expected an error for the failed webhook")
+	}
+}
+
+func Test_postEventsRetry(t *testing.T) {
+	retries, backoff := webhookPoster.Retries, webhookPoster.Backoff
+	webhookPoster.Backoff = 0
+	t.Cleanup(func() { webhookPoster.Retries, webhookPoster.Backoff = retries, backoff })
+
+	var requests int
+	status := http.StatusServiceUnavailable
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(status)
+		}
+	}))
+	defer server.Close()
+
+	events := []*models.Event{event("1", "image", "create", "info", "a", 1)}
+	if err := postEvents(server.URL, events); err != nil || requests != 2 {
+		t.Errorf("postEvents() error = %v after %d requests, want the delivery on the retry", err, requests)
+	}
+
+	requests, status = 0, http.StatusBadRequest
+	if err := postEvents(server.URL, events); err == nil || requests != 1 {
+		t.Errorf("postEvents() error = %v after %d requests, want the bad request not retried", err, requests)
+	}
+}
+
+func Test_trackerForwardFailed(t *testing.T) {
+	retries := webhookPoster.Retries
+	webhookPoster.Retries = 0
+	t.Cleanup(func() { webhookPoster.Retries = retries })
+
+	var requests int
+	var got []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		scanner := bufio.NewScanner(r.Body)
+		for scanner.Scan() {
+			var e models.Event
+			if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+				t.Errorf("invalid JSON line %s, err: %v", scanner.Text(), err)
+			}
+			got = append(got, *e.EventID)
+		}
+	}))
+	defer server.Close()
+	emit := func(events []*models.Event) error { return postEvents(server.URL, events) }
+
+	tr := newTracker(time.Minute)
+	if err := tr.forward([]*models.Event{event("1", "image", "create", "info", "a", 1)}, emit); err == nil {
+		t.Fatalf("forward() expected an error for the failed webhook")
+	}
+	if err := tr.forward([]*models.Event{event("1", "image", "create", "info", "a", 1), event("2", "image", "delete", "info", "a", 2)}, emit); err != nil {
+		t.Fatalf("forward() error = %v", err)
+	}
+	if !equal(got, []string{"1", "2"}) {
+		t.Errorf("forward() delivered %v, want the failed event delivered on the next poll", got)
+	}
+}
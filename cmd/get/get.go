@@ -17,10 +17,12 @@ package get
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/ppc64le-cloud/pvsadm/cmd/get/accounts"
 	"github.com/ppc64le-cloud/pvsadm/cmd/get/cloudconnections"
 	"github.com/ppc64le-cloud/pvsadm/cmd/get/events"
 	"github.com/ppc64le-cloud/pvsadm/cmd/get/peravailability"
 	"github.com/ppc64le-cloud/pvsadm/cmd/get/ports"
+	"github.com/ppc64le-cloud/pvsadm/cmd/get/workspaces"
 	"github.com/ppc64le-cloud/pvsadm/pkg"
 )
 
@@ -32,14 +34,16 @@ var Cmd = &cobra.Command{
 }
 
 func init() {
+	Cmd.AddCommand(accounts.Cmd)
 	Cmd.AddCommand(cloudconnections.Cmd)
 	Cmd.AddCommand(events.Cmd)
 	Cmd.AddCommand(peravailability.Cmd)
 	Cmd.AddCommand(ports.Cmd)
+	Cmd.AddCommand(workspaces.Cmd)
 	Cmd.PersistentFlags().StringVarP(&pkg.Options.WorkspaceID, "instance-id", "i", "", "Instance ID of the PowerVS instance")
 	Cmd.PersistentFlags().MarkDeprecated("instance-id", "instance-id is deprecated, workspace-id should be used")
 	Cmd.PersistentFlags().StringVarP(&pkg.Options.WorkspaceName, "instance-name", "n", "", "Instance name of the PowerVS")
 	Cmd.PersistentFlags().MarkDeprecated("instance-name", "instance-name is deprecated, workspace-name should be used")
-	Cmd.PersistentFlags().StringVarP(&pkg.Options.WorkspaceID, "workspace-id", "", "", "Workspace ID of the PowerVS instance")
+	Cmd.PersistentFlags().StringVarP(&pkg.Options.WorkspaceID, "workspace-id", "", "", "Workspace ID or CRN of the PowerVS instance")
 	Cmd.PersistentFlags().StringVarP(&pkg.Options.WorkspaceName, "workspace-name", "", "", "Workspace name of the PowerVS")
 }
@@ -22,7 +22,6 @@ import (
 
 	"github.com/ppc64le-cloud/pvsadm/pkg"
 	"github.com/ppc64le-cloud/pvsadm/pkg/client"
-	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
 )
 
 const powerEdgeRouter = "power-edge-router"
@@ -32,7 +31,7 @@ var Cmd = &cobra.Command{
 	Short: "List regions that support PER",
 	Long:  "List regions that support Power Edge Router (PER)",
 	PreRunE: func(cmd *cobra.Command, args []string) error {
-		return utils.EnsurePrerequisitesAreSet(pkg.Options.APIKey, pkg.Options.WorkspaceID, pkg.Options.WorkspaceName)
+		return client.EnsureWorkspace(&pkg.Options.WorkspaceID, &pkg.Options.WorkspaceName)
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var perEnabledRegions []string
@@ -0,0 +1,203 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspaces
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/client"
+	"github.com/ppc64le-cloud/pvsadm/pkg/inventory"
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+// maxConcurrency is the maximum number of the workspaces counted in parallel
+const maxConcurrency = 10
+
+var withCounts bool
+
+// workspace is the row of the workspaces table
+type workspace struct {
+	name, guid, zone, resourceGroup, state string
+	created                                time.Time
+	counts                                 *counts
+	err                                    error
+}
+
+// counts are the number of the resources in the workspace
+type counts struct {
+	instances, volumes, networks, images int
+}
+
+var Cmd = &cobra.Command{
+	Use:   "workspaces",
+	Short: "List the PowerVS workspaces in the account",
+	Long: `List the PowerVS workspaces in the account with the zone, resource group, state and the creation date
+
+Examples:
+# List the workspaces
+pvsadm get workspaces
+
+# List the workspaces with the number of the instances, volumes, networks and images
+pvsadm get workspaces --with-counts
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opt := pkg.Options
+		c, err := client.NewClientWithEnv(opt.APIKey, opt.Environment, opt.Debug)
+		if err != nil {
+			klog.Errorf("failed to create a session with IBM cloud: %v", err)
+			return err
+		}
+
+		instances, err := c.ListWorkspaceInstances()
+		if err != nil {
+			return err
+		}
+		if len(instances.Resources) == 0 {
+			klog.Info("There are no PowerVS workspaces in this account.")
+			return nil
+		}
+
+		groups, err := c.ListResourceGroups()
+		if err != nil {
+			klog.Warningf("Resource group IDs are shown instead of the names, err: %v", err)
+		}
+		workspaces := toWorkspaces(instances.Resources, groups)
+
+		if withCounts {
+			klog.Info("Counting the resources across all workspaces, please wait..")
+			byGUID := map[string]*resourcecontrollerv2.ResourceInstance{}
+			for i := range instances.Resources {
+				byGUID[*instances.Resources[i].GUID] = &instances.Resources[i]
+			}
+			countAll(workspaces, maxConcurrency, func(ws *workspace) (*counts, error) {
+				session, err := c.PISession(ws.zone)
+				if err != nil {
+					return nil, err
+				}
+				return countResources(client.NewWorkspacePVMClient(byGUID[ws.guid], session))
+			})
+		}
+
+		table := utils.NewTable()
+		headers := []string{"Name", "ID", "Zone", "Resource Group", "State", "Created"}
+		if withCounts {
+			headers = append(headers, "Instances", "Volumes", "Networks", "Images")
+		}
+		table.SetHeader(headers)
+		for _, ws := range workspaces {
+			row := []string{ws.name, ws.guid, ws.zone, ws.resourceGroup, ws.state, ws.created.Format(time.RFC3339)}
+			if withCounts {
+				row = append(row, ws.countColumns()...)
+			}
+			table.Append(row)
+		}
+		table.Table.Render()
+		return nil
+	},
+}
+
+func init() {
+	Cmd.Flags().BoolVar(&withCounts, "with-counts", false, "Show the number of the instances, volumes, networks and images in each workspace")
+}
+
+// toWorkspaces returns the workspaces sorted by the zone and the name, the resource group is shown by the name if known
+func toWorkspaces(instances []resourcecontrollerv2.ResourceInstance, groups map[string]string) []*workspace {
+	var workspaces []*workspace
+	for _, i := range instances {
+		ws := &workspace{
+			name:          ptr.Deref(i.Name, ""),
+			guid:          ptr.Deref(i.GUID, ""),
+			zone:          ptr.Deref(i.RegionID, ""),
+			resourceGroup: ptr.Deref(i.ResourceGroupID, ""),
+			state:         ptr.Deref(i.State, ""),
+		}
+		if name, ok := groups[ws.resourceGroup]; ok {
+			ws.resourceGroup = name
+		}
+		if i.CreatedAt != nil {
+			ws.created = time.Time(*i.CreatedAt).UTC()
+		}
+		workspaces = append(workspaces, ws)
+	}
+	sort.Slice(workspaces, func(i, j int) bool {
+		if workspaces[i].zone != workspaces[j].zone {
+			return workspaces[i].zone < workspaces[j].zone
+		}
+		return workspaces[i].name < workspaces[j].name
+	})
+	return workspaces
+}
+
+// countAll counts the resources of the workspaces with at most concurrency workspaces in parallel
+func countAll(workspaces []*workspace, concurrency int, count func(*workspace) (*counts, error)) {
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for _, ws := range workspaces {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(ws *workspace) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			ws.counts, ws.err = count(ws)
+			if ws.err != nil {
+				klog.Warningf("failed to count the resources of the workspace %s, err: %v", ws.name, ws.err)
+			}
+		}(ws)
+	}
+	wg.Wait()
+}
+
+// countResources returns the number of the instances, volumes, networks and images of the workspace
+func countResources(pvmclient *client.PVMClient) (*counts, error) {
+	summary, err := inventory.Summarize(pvmclient)
+	if err != nil {
+		return nil, err
+	}
+	c := &counts{}
+	for _, s := range summary {
+		switch s.Kind {
+		case inventory.KindInstance:
+			c.instances = s.Count
+		case inventory.KindVolume:
+			c.volumes = s.Count
+		case inventory.KindNetwork:
+			c.networks = s.Count
+		case inventory.KindImage:
+			c.images = s.Count
+		}
+	}
+	return c, nil
+}
+
+func (ws *workspace) countColumns() []string {
+	if ws.counts == nil {
+		return []string{"error", "error", "error", "error"}
+	}
+	return []string{
+		strconv.Itoa(ws.counts.instances),
+		strconv.Itoa(ws.counts.volumes),
+		strconv.Itoa(ws.counts.networks),
+		strconv.Itoa(ws.counts.images),
+	}
+}
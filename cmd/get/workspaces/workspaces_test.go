@@ -0,0 +1,86 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspaces
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/go-openapi/strfmt"
+	"k8s.io/utils/ptr"
+)
+
+func Test_toWorkspaces(t *testing.T) {
+	created := strfmt.DateTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	instances := []resourcecontrollerv2.ResourceInstance{
+		{Name: ptr.To("b"), GUID: ptr.To("2"), RegionID: ptr.To("lon04"), ResourceGroupID: ptr.To("rg-1"), State: ptr.To("active")},
+		{Name: ptr.To("c"), GUID: ptr.To("3"), RegionID: ptr.To("dal10"), ResourceGroupID: ptr.To("rg-unknown"), CreatedAt: &created},
+		{Name: ptr.To("a"), GUID: ptr.To("1"), RegionID: ptr.To("lon04"), ResourceGroupID: ptr.To("rg-1")},
+	}
+	got := toWorkspaces(instances, map[string]string{"rg-1": "default"})
+	var order []string
+	for _, ws := range got {
+		order = append(order, ws.name)
+	}
+	if fmt.Sprint(order) != "[c a b]" {
+		t.Errorf("toWorkspaces() order = %v, want [c a b]", order)
+	}
+	if got[1].resourceGroup != "default" || got[0].resourceGroup != "rg-unknown" {
+		t.Errorf("toWorkspaces() resource groups = %s, %s, want rg-unknown, default", got[0].resourceGroup, got[1].resourceGroup)
+	}
+	if !got[0].created.Equal(time.Time(created)) {
+		t.Errorf("toWorkspaces() created = %v, want %v", got[0].created, time.Time(created))
+	}
+}
+
+func Test_countAll(t *testing.T) {
+	var workspaces []*workspace
+	for i := 0; i < 20; i++ {
+		workspaces = append(workspaces, &workspace{name: fmt.Sprintf("ws-%d", i)})
+	}
+	var running, peak int32
+	countAll(workspaces, 3, func(ws *workspace) (*counts, error) {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		if ws.name == "ws-7" {
+			return nil, fmt.Errorf("forbidden")
+		}
+		return &counts{instances: len(ws.name)}, nil
+	})
+	if peak > 3 {
+		t.Errorf("countAll() ran %d workspaces in parallel, want at most 3", peak)
+	}
+	for _, ws := range workspaces {
+		if ws.name == "ws-7" {
+			if ws.err == nil || ws.countColumns()[0] != "error" {
+				t.Errorf("countAll() expected the error for %s", ws.name)
+			}
+			continue
+		}
+		if ws.counts == nil || ws.counts.instances != len(ws.name) {
+			t.Errorf("countAll() counts of %s = %+v", ws.name, ws.counts)
+		}
+	}
+}
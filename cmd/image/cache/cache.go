@@ -0,0 +1,36 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/cache"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Manage the local image cache",
+	Long: `Manage the local image cache used by the qcow2ova command to avoid downloading the same base image across the runs
+pvsadm image cache --help for information
+`,
+}
+
+func init() {
+	Cmd.AddCommand(listCmd)
+	Cmd.AddCommand(pruneCmd)
+	Cmd.PersistentFlags().StringVar(&pkg.ImageCMDOptions.ImageCacheDir, "cache-dir", cache.DefaultDir(), "Image cache directory")
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/cache"
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the cached images",
+	Long: `List the cached images
+pvsadm image cache list --help for information
+
+Examples:
+# List the images in the default cache directory
+pvsadm image cache list
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := cache.New(pkg.ImageCMDOptions.ImageCacheDir)
+		if err != nil {
+			return err
+		}
+		entries, err := c.List()
+		if err != nil {
+			return fmt.Errorf("failed to list the cached images, err: %v", err)
+		}
+		if len(entries) == 0 {
+			klog.Infof("No images found in the cache %s", c.Dir)
+			return nil
+		}
+		var total int64
+		table := utils.NewTable()
+		table.SetHeader([]string{"Key", "URL", "Size", "Checksum/ETag", "Last Used"})
+		for _, entry := range entries {
+			id := entry.Checksum
+			if id == "" {
+				id = entry.ETag
+			}
+			total += entry.Size
+			table.Append([]string{shortKey(entry.Key), entry.URL, utils.FormatBytes(entry.Size), id, entry.LastUsed.Format("2006-01-02 15:04:05")})
+		}
+		table.Table.Render()
+		klog.Infof("Total: %d image(s), %s", len(entries), utils.FormatBytes(total))
+		return nil
+	},
+}
+
+// shortKey returns the first 12 characters of the cache key, the keys written by hand may be shorter
+func shortKey(key string) string {
+	if len(key) > 12 {
+		return key[:12]
+	}
+	return key
+}
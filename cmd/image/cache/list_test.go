@@ -0,0 +1,33 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import "testing"
+
+func Test_shortKey(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"", ""},
+		{"abc", "abc"},
+		{"0123456789abcdef", "0123456789ab"},
+	}
+	for _, tt := range tests {
+		if got := shortKey(tt.key); got != tt.want {
+			t.Errorf("shortKey(%q) = %q, want %q", tt.key, got, tt.want)
+		}
+	}
+}
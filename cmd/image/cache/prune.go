@@ -0,0 +1,92 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/cache"
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+var pruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Remove the images from the cache",
+	Long: `Remove the images from the cache
+pvsadm image cache prune --help for information
+
+Examples:
+# Remove all the cached images
+pvsadm image cache prune --all
+
+# Remove the images not used in the last 30 days
+pvsadm image cache prune --older-than 720h
+
+# Evict the least recently used images to keep the cache within 20GB
+pvsadm image cache prune --max-size 20
+`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		opt := pkg.ImageCMDOptions
+		if !opt.CachePruneAll && opt.CacheOlderThan == 0 && opt.CachePruneMaxSize == 0 {
+			return fmt.Errorf("one of --all, --older-than or --max-size is required")
+		}
+		if opt.CachePruneMaxSize < 0 || opt.CacheOlderThan < 0 {
+			return fmt.Errorf("--max-size and --older-than can't be negative")
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opt := pkg.ImageCMDOptions
+		c, err := cache.New(opt.ImageCacheDir)
+		if err != nil {
+			return err
+		}
+		var removed []*cache.Entry
+		if opt.CachePruneAll {
+			entries, err := c.List()
+			if err != nil {
+				return fmt.Errorf("failed to list the cached images, err: %v", err)
+			}
+			for _, entry := range entries {
+				if err := c.Remove(entry.Key); err != nil {
+					return fmt.Errorf("failed to remove the cached image for %s, err: %v", entry.URL, err)
+				}
+				removed = append(removed, entry)
+			}
+		} else {
+			removed, err = c.Prune(opt.CachePruneMaxSize*1024*1024*1024, opt.CacheOlderThan)
+			if err != nil {
+				return fmt.Errorf("failed to prune the image cache, err: %v", err)
+			}
+		}
+		var freed int64
+		for _, entry := range removed {
+			klog.V(1).Infof("Removed the cached image for %s", entry.URL)
+			freed += entry.Size
+		}
+		klog.Infof("Removed %d image(s) from the cache, freed %s", len(removed), utils.FormatBytes(freed))
+		return nil
+	},
+}
+
+func init() {
+	pruneCmd.Flags().BoolVar(&pkg.ImageCMDOptions.CachePruneAll, "all", false, "Remove all the cached images")
+	pruneCmd.Flags().DurationVar(&pkg.ImageCMDOptions.CacheOlderThan, "older-than", 0, "Remove the images not used for the given duration, e.g: 720h")
+	pruneCmd.Flags().Int64Var(&pkg.ImageCMDOptions.CachePruneMaxSize, "max-size", 0, "Evict the least recently used images to keep the cache within the size (in GB)")
+}
@@ -0,0 +1,143 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capture
+
+import (
+	"fmt"
+	"time"
+
+	pmodels "github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+
+	"github.com/ppc64le-cloud/pvsadm/cmd/image/export"
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/audit"
+	"github.com/ppc64le-cloud/pvsadm/pkg/client"
+)
+
+const (
+	destinationImageCatalog = "image-catalog"
+	destinationCloudStorage = "cloud-storage"
+	destinationBoth         = "both"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "capture",
+	Short: "Capture the PowerVS instance to the image catalog and/or the Cloud Object Storage bucket",
+	Long: `Capture the PowerVS instance to the image catalog and/or the Cloud Object Storage bucket
+pvsadm image capture --help for information
+
+# Set the API key or feed the --api-key commandline argument
+export IBMCLOUD_APIKEY=<IBMCLOUD_APIKEY>
+
+Examples:
+
+# Capture the instance to the image catalog
+pvsadm image capture --workspace-name upstream-core-lon04 --vm rhel-86-vm --capture-name rhel-86-golden
+
+# Capture the instance to the bucket and download the captured image into the current directory
+pvsadm image capture --workspace-name upstream-core-lon04 --vm rhel-86-vm --capture-name rhel-86-golden --destination cloud-storage -b <BUCKETNAME> -r <REGION> --accesskey <ACCESSKEY> --secretkey <SECRETKEY> --download
+`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		opt := pkg.ImageCMDOptions
+		switch opt.CaptureDestination {
+		case destinationImageCatalog:
+			if opt.Download {
+				return fmt.Errorf("--download is supported only for the %s and %s destinations", destinationCloudStorage, destinationBoth)
+			}
+		case destinationCloudStorage, destinationBoth:
+			// The Region is shared with the upload command defaulting its --bucket-region, it is set even if omitted here
+			if opt.BucketName == "" || !cmd.Flags().Changed("bucket-region") || opt.AccessKey == "" || opt.SecretKey == "" {
+				return fmt.Errorf("--bucket, --bucket-region, --accesskey and --secretkey are required for the %s destination", opt.CaptureDestination)
+			}
+		default:
+			return fmt.Errorf("unsupported destination %q, supported: %s, %s, %s", opt.CaptureDestination, destinationImageCatalog, destinationCloudStorage, destinationBoth)
+		}
+		return client.EnsureWorkspace(&opt.WorkspaceID, &opt.WorkspaceName)
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opt := pkg.ImageCMDOptions
+
+		pvsClient, err := client.NewClientWithEnv(pkg.Options.APIKey, pkg.Options.Environment, pkg.Options.Debug)
+		if err != nil {
+			return err
+		}
+		pvmclient, err := client.NewPVMClientWithEnv(pvsClient, opt.WorkspaceID, opt.WorkspaceName, pkg.Options.Environment)
+		if err != nil {
+			return err
+		}
+
+		ins, err := pvmclient.InstanceClient.GetByNameOrID(opt.CaptureVM)
+		if err != nil {
+			return err
+		}
+
+		body := pmodels.PVMInstanceCapture{
+			CaptureDestination: &opt.CaptureDestination,
+			CaptureName:        &opt.ImageName,
+			CaptureVolumeIDs:   opt.CaptureVolumes,
+		}
+		if opt.CaptureDestination != destinationImageCatalog {
+			body.CloudStorageImagePath = opt.BucketName
+			body.CloudStorageRegion = opt.Region
+			body.CloudStorageAccessKey = opt.AccessKey
+			body.CloudStorageSecretKey = opt.SecretKey
+		}
+
+		klog.Infof("Capturing the instance %s as %s to the %s. Please wait...", *ins.ServerName, opt.ImageName, opt.CaptureDestination)
+		entry := audit.Entry{Name: "vms", Operation: "capture", Workspace: pvmclient.InstanceID, ResourceID: *ins.PvmInstanceID,
+			Value: *ins.ServerName + " -> " + opt.ImageName + " (" + opt.CaptureDestination + ")"}
+		if opt.CaptureDestination != destinationImageCatalog {
+			entry.Value += " " + opt.BucketName
+		}
+		jobRef, err := pvmclient.InstanceClient.Capture(*ins.PvmInstanceID, body)
+		if err != nil {
+			audit.Record(entry, err)
+			return fmt.Errorf("failed to capture the instance %s, err: %v", *ins.ServerName, err)
+		}
+		start := time.Now()
+		err = pvmclient.JobClient.WaitForCompletion(*jobRef.ID, "instance capture", 30*time.Second, opt.WatchTimeout)
+		audit.Record(entry, err)
+		if err != nil {
+			return err
+		}
+		klog.Infof("Successfully captured the instance %s as %s, Total time taken: %s", *ins.ServerName, opt.ImageName, time.Since(start).Round(time.Second))
+
+		if !opt.Download {
+			return nil
+		}
+		return export.Download(opt.AccessKey, opt.SecretKey, opt.Region, opt.BucketName, opt.ImageName, opt.OutputFile)
+	},
+}
+
+func init() {
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.WorkspaceName, "workspace-name", "", "PowerVS Workspace name.")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.WorkspaceID, "workspace-id", "", "PowerVS Workspace ID or CRN.")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.CaptureVM, "vm", "", "Name or ID of the PowerVS instance to capture.")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.ImageName, "capture-name", "", "Name of the captured image.")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.CaptureDestination, "destination", destinationImageCatalog, "Destination of the captured image, accepted values are [image-catalog, cloud-storage, both].")
+	Cmd.Flags().StringSliceVar(&pkg.ImageCMDOptions.CaptureVolumes, "volumes", nil, "IDs of the data volumes to include in the captured image.")
+	Cmd.Flags().StringVarP(&pkg.ImageCMDOptions.BucketName, "bucket", "b", "", "Cloud Object Storage bucket name, required for the cloud-storage and both destinations.")
+	Cmd.Flags().StringVarP(&pkg.ImageCMDOptions.Region, "bucket-region", "r", "", "Cloud Object Storage bucket location.")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.AccessKey, "accesskey", "", "Cloud Object Storage HMAC access key.")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.SecretKey, "secretkey", "", "Cloud Object Storage HMAC secret key.")
+	Cmd.Flags().BoolVar(&pkg.ImageCMDOptions.Download, "download", false, "Download the captured image from the bucket")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.OutputFile, "output-file", "", "Path of the downloaded image(default: <object name> in the current directory)")
+	Cmd.Flags().DurationVar(&pkg.ImageCMDOptions.WatchTimeout, "watch-timeout", 1*time.Hour, "watch timeout")
+	_ = Cmd.MarkFlagRequired("vm")
+	_ = Cmd.MarkFlagRequired("capture-name")
+	Cmd.Flags().SortFlags = false
+}
@@ -0,0 +1,166 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/audit"
+	"github.com/ppc64le-cloud/pvsadm/pkg/client"
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the PowerVS image to the Cloud Object Storage bucket",
+	Long: `Export the PowerVS image to the Cloud Object Storage bucket
+pvsadm image export --help for information
+
+# Set the API key or feed the --api-key commandline argument
+export IBMCLOUD_APIKEY=<IBMCLOUD_APIKEY>
+
+Examples:
+
+# Export the image to the bucket
+pvsadm image export --workspace-name upstream-core-lon04 --image rhel-86 -b <BUCKETNAME> -r <REGION> --accesskey <ACCESSKEY> --secretkey <SECRETKEY>
+
+# Export the image to the bucket and download the exported image into the current directory
+pvsadm image export --workspace-name upstream-core-lon04 --image rhel-86 -b <BUCKETNAME> -r <REGION> --accesskey <ACCESSKEY> --secretkey <SECRETKEY> --download
+`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return client.EnsureWorkspace(&pkg.ImageCMDOptions.WorkspaceID, &pkg.ImageCMDOptions.WorkspaceName)
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opt := pkg.ImageCMDOptions
+
+		pvsClient, err := client.NewClientWithEnv(pkg.Options.APIKey, pkg.Options.Environment, pkg.Options.Debug)
+		if err != nil {
+			return err
+		}
+		pvmclient, err := client.NewPVMClientWithEnv(pvsClient, opt.WorkspaceID, opt.WorkspaceName, pkg.Options.Environment)
+		if err != nil {
+			return err
+		}
+
+		imageID, imageName, err := FindImage(pvmclient, opt.ExportImage)
+		if err != nil {
+			return err
+		}
+
+		klog.Infof("Exporting the image %s to the bucket %s. Please wait...", imageName, opt.BucketName)
+		entry := audit.Entry{Name: "images", Operation: "export", Workspace: pvmclient.InstanceID, ResourceID: imageID, Value: pvmclient.InstanceName + ":" + imageName + " -> " + opt.BucketName}
+		jobRef, err := pvmclient.ImgClient.ExportImage(imageID, opt.BucketName, opt.Region, opt.AccessKey, opt.SecretKey)
+		if err != nil {
+			audit.Record(entry, err)
+			return fmt.Errorf("failed to export the image %s, err: %v", imageName, err)
+		}
+		start := time.Now()
+		err = pvmclient.JobClient.WaitForCompletion(*jobRef.ID, "image export", 30*time.Second, opt.WatchTimeout)
+		audit.Record(entry, err)
+		if err != nil {
+			return err
+		}
+		klog.Infof("Successfully exported the image %s to the bucket %s, Total time taken: %s", imageName, opt.BucketName, time.Since(start).Round(time.Second))
+
+		if !opt.Download {
+			return nil
+		}
+		return Download(opt.AccessKey, opt.SecretKey, opt.Region, opt.BucketName, imageName, opt.OutputFile)
+	},
+}
+
+func init() {
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.WorkspaceName, "workspace-name", "", "PowerVS Workspace name.")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.WorkspaceID, "workspace-id", "", "PowerVS Workspace ID or CRN.")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.ExportImage, "image", "", "Name or ID of the PowerVS image to export.")
+	Cmd.Flags().StringVarP(&pkg.ImageCMDOptions.BucketName, "bucket", "b", "", "Cloud Object Storage bucket name.")
+	Cmd.Flags().StringVarP(&pkg.ImageCMDOptions.Region, "bucket-region", "r", "", "Cloud Object Storage bucket location.")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.AccessKey, "accesskey", "", "Cloud Object Storage HMAC access key.")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.SecretKey, "secretkey", "", "Cloud Object Storage HMAC secret key.")
+	Cmd.Flags().BoolVar(&pkg.ImageCMDOptions.Download, "download", false, "Download the exported image from the bucket")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.OutputFile, "output-file", "", "Path of the downloaded image(default: <object name> in the current directory)")
+	Cmd.Flags().DurationVar(&pkg.ImageCMDOptions.WatchTimeout, "watch-timeout", 1*time.Hour, "watch timeout")
+	_ = Cmd.MarkFlagRequired("image")
+	_ = Cmd.MarkFlagRequired("bucket")
+	_ = Cmd.MarkFlagRequired("bucket-region")
+	_ = Cmd.MarkFlagRequired("accesskey")
+	_ = Cmd.MarkFlagRequired("secretkey")
+	Cmd.Flags().SortFlags = false
+}
+
+// FindImage returns the ID and the name of the image with the name or the ID
+func FindImage(pvmclient *client.PVMClient, nameOrID string) (string, string, error) {
+	ref, err := pvmclient.ImgClient.GetImageByName(nameOrID)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get the list of images, err: %v", err)
+	}
+	if ref != nil {
+		return *ref.ImageID, *ref.Name, nil
+	}
+	img, err := pvmclient.ImgClient.Get(nameOrID)
+	if err != nil {
+		return "", "", fmt.Errorf("image %s not found in the workspace %s", nameOrID, pvmclient.InstanceName)
+	}
+	return *img.ImageID, *img.Name, nil
+}
+
+// Download downloads the image exported with the name from the bucket into the file, the object name is used as the
+// file name in the current directory if the file is empty
+func Download(accessKey, secretKey, region, bucketName, name, file string) error {
+	s3Client, err := client.NewS3ClientWithKeys(accessKey, secretKey, region)
+	if err != nil {
+		return err
+	}
+	object, err := FindObject(s3Client, bucketName, name)
+	if err != nil {
+		return err
+	}
+	if file == "" {
+		file = object
+	}
+	if _, err := os.Stat(file); err == nil {
+		return fmt.Errorf("%s already exists", file)
+	}
+	return s3Client.DownloadObject(bucketName, object, file)
+}
+
+// FindObject returns the object of the image exported with the name, PowerVS names the object as <name>.ova.gz
+func FindObject(s3Client *client.S3Client, bucketName, name string) (string, error) {
+	objects, err := s3Client.SelectObjects(bucketName, "^"+regexp.QuoteMeta(name)+`.*\.ova\.gz$`)
+	if err != nil {
+		return "", err
+	}
+	return pickObject(objects, name, bucketName)
+}
+
+func pickObject(objects []string, name, bucketName string) (string, error) {
+	switch len(objects) {
+	case 0:
+		return "", fmt.Errorf("exported image %s is not found in the bucket %s", name, bucketName)
+	case 1:
+		return objects[0], nil
+	}
+	if utils.Contains(objects, name+".ova.gz") {
+		return name + ".ova.gz", nil
+	}
+	return "", fmt.Errorf("multiple objects found for the image %s in the bucket %s: %v", name, bucketName, objects)
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import "testing"
+
+func Test_pickObject(t *testing.T) {
+	tests := []struct {
+		name    string
+		objects []string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "no objects",
+			wantErr: true,
+		},
+		{
+			name:    "single object",
+			objects: []string{"rhel-86-1.ova.gz"},
+			want:    "rhel-86-1.ova.gz",
+		},
+		{
+			name:    "exact match among multiple objects",
+			objects: []string{"rhel-86-golden.ova.gz", "rhel-86.ova.gz"},
+			want:    "rhel-86.ova.gz",
+		},
+		{
+			name:    "ambiguous objects",
+			objects: []string{"rhel-86-a.ova.gz", "rhel-86-b.ova.gz"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pickObject(tt.objects, "rhel-86", "images")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("pickObject() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("pickObject() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
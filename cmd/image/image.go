@@ -15,11 +15,18 @@
 package image
 
 import (
+	"github.com/ppc64le-cloud/pvsadm/cmd/image/cache"
+	"github.com/ppc64le-cloud/pvsadm/cmd/image/capture"
+	"github.com/ppc64le-cloud/pvsadm/cmd/image/export"
 	_import "github.com/ppc64le-cloud/pvsadm/cmd/image/import"
+	"github.com/ppc64le-cloud/pvsadm/cmd/image/importstock"
 	"github.com/ppc64le-cloud/pvsadm/cmd/image/info"
+	"github.com/ppc64le-cloud/pvsadm/cmd/image/ova2qcow2"
+	"github.com/ppc64le-cloud/pvsadm/cmd/image/promote"
 	"github.com/ppc64le-cloud/pvsadm/cmd/image/qcow2ova"
 	"github.com/ppc64le-cloud/pvsadm/cmd/image/sync"
 	"github.com/ppc64le-cloud/pvsadm/cmd/image/upload"
+	"github.com/ppc64le-cloud/pvsadm/cmd/image/verify"
 	"github.com/spf13/cobra"
 )
 
@@ -36,4 +43,11 @@ func init() {
 	Cmd.AddCommand(upload.Cmd)
 	Cmd.AddCommand(sync.Cmd)
 	Cmd.AddCommand(info.Cmd)
+	Cmd.AddCommand(cache.Cmd)
+	Cmd.AddCommand(verify.Cmd)
+	Cmd.AddCommand(ova2qcow2.Cmd)
+	Cmd.AddCommand(export.Cmd)
+	Cmd.AddCommand(capture.Cmd)
+	Cmd.AddCommand(promote.Cmd)
+	Cmd.AddCommand(importstock.Cmd)
 }
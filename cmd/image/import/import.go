@@ -26,6 +26,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/audit"
 	"github.com/ppc64le-cloud/pvsadm/pkg/client"
 	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
 )
@@ -35,8 +36,6 @@ const (
 	cosHmacKeys          = "cos_hmac_keys"
 	crnServiceRoleWriter = "crn:v1:bluemix:public:iam::::serviceRole:Writer"
 	imageStateActive     = "active"
-	jobStateCompleted    = "completed"
-	jobStateFailed       = "failed"
 	secretAccessKey      = "secret_access_key"
 	// CosResourceID is IBM COS service id, can be retrieved using ibmcloud cli
 	// ibmcloud catalog service cloud-object-storage.
@@ -144,7 +143,7 @@ pvsadm image import -n upstream-core-lon04 -b <BUCKETNAME> --object rhel-83-1003
 		if (len(pkg.ImageCMDOptions.AccessKey) > 0) != (len(pkg.ImageCMDOptions.SecretKey) > 0) {
 			return fmt.Errorf("required both --accesskey and --secretkey values")
 		}
-		return utils.EnsurePrerequisitesAreSet(pkg.Options.APIKey, pkg.ImageCMDOptions.WorkspaceID, pkg.ImageCMDOptions.WorkspaceName)
+		return client.EnsureWorkspace(&pkg.ImageCMDOptions.WorkspaceID, &pkg.ImageCMDOptions.WorkspaceName)
 	},
 
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -256,27 +255,17 @@ pvsadm image import -n upstream-core-lon04 -b <BUCKETNAME> --object rhel-83-1003
 			bucketAccess = "public"
 		}
 		klog.Infof("Importing image %s. Please wait...", opt.ImageName)
+		entry := audit.Entry{Name: "images", Operation: "import", Workspace: pvmclient.InstanceID, Value: opt.ImageName + " <- " + opt.BucketName + "/" + opt.ImageFilename}
 		jobRef, err := pvmclient.ImgClient.ImportImage(opt.ImageName, opt.ImageFilename, opt.Region,
 			opt.AccessKey, opt.SecretKey, opt.BucketName, strings.ToLower(opt.StorageType), bucketAccess)
 		if err != nil {
+			audit.Record(entry, err)
 			return err
 		}
 		start := time.Now()
-		err = utils.SpinnerPollUntil(time.Tick(2*time.Minute), time.After(opt.WatchTimeout), func() (string, bool, error) {
-			job, err := pvmclient.JobClient.Get(*jobRef.ID)
-			if err != nil {
-				return "", false, fmt.Errorf("image import job failed to complete, err: %v", err)
-			}
-			if *job.Status.State == jobStateCompleted {
-				return "", true, nil
-			}
-			if *job.Status.State == jobStateFailed {
-				return "", false, fmt.Errorf("image import job failed to complete, err: %v", job.Status.Message)
-			}
-			message := fmt.Sprintf("Image import is in-progress, current state: %s", *job.Status.State)
-			return message, false, nil
-		})
+		err = pvmclient.JobClient.WaitForCompletion(*jobRef.ID, "image import", 2*time.Minute, opt.WatchTimeout)
 		if err != nil {
+			audit.Record(entry, err)
 			return err
 		}
 
@@ -286,9 +275,12 @@ pvsadm image import -n upstream-core-lon04 -b <BUCKETNAME> --object rhel-83-1003
 		if image.ImageID == nil {
 			image, err = pvmclient.ImgClient.GetImageByName(opt.ImageName)
 			if err != nil {
+				audit.Record(entry, err)
 				return err
 			}
 		}
+		entry.ResourceID = *image.ImageID
+		audit.Record(entry, nil)
 
 		if !opt.Watch {
 			klog.Infof("Image import for %s is currently in %s state, Please check the progress in the IBM cloud UI", *image.Name, *image.State)
@@ -323,7 +315,7 @@ func init() {
 	Cmd.Flags().StringVarP(&pkg.ImageCMDOptions.WorkspaceID, "pvs-instance-id", "i", "", "PowerVS Instance ID.")
 	Cmd.Flags().MarkDeprecated("pvs-instance-id", "pvs-instance-id is deprecated, workspace-id should be used")
 	Cmd.Flags().StringVarP(&pkg.ImageCMDOptions.WorkspaceName, "workspace-name", "", "", "PowerVS Workspace name.")
-	Cmd.Flags().StringVarP(&pkg.ImageCMDOptions.WorkspaceID, "workspace-id", "", "", "PowerVS Workspace ID.")
+	Cmd.Flags().StringVarP(&pkg.ImageCMDOptions.WorkspaceID, "workspace-id", "", "", "PowerVS Workspace ID or CRN.")
 	Cmd.Flags().StringVarP(&pkg.ImageCMDOptions.BucketName, "bucket", "b", "", "Cloud Object Storage bucket name.")
 	Cmd.Flags().StringVarP(&pkg.ImageCMDOptions.COSInstanceName, "cos-instance-name", "s", "", "Cloud Object Storage instance name.")
 	// TODO It's deprecated and will be removed in a future release
@@ -0,0 +1,187 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importstock
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	pmodels "github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/audit"
+	"github.com/ppc64le-cloud/pvsadm/pkg/client"
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+const imageStateActive = "active"
+
+var Cmd = &cobra.Command{
+	Use:   "import-stock",
+	Short: "Import the stock images from the PowerVS catalog into the workspace",
+	Long: `Import the stock images from the PowerVS catalog into the workspace
+pvsadm image import-stock --help for information
+
+The stock images with the name matching the --name regular expression are listed from the catalog of the region,
+the image to import is selected interactively if multiple images are matched, use --all to import all the matched images.
+Without a terminal, the --name must match a single image unless --all is set.
+
+# Set the API key or feed the --api-key commandline argument
+export IBMCLOUD_APIKEY=<IBMCLOUD_APIKEY>
+
+Examples:
+
+# Import the RHEL 9.2 stock image
+pvsadm image import-stock --workspace-name upstream-core-lon04 --name "^RHEL9-SP2$"
+
+# Import all the AIX 7.3 stock images
+pvsadm image import-stock --workspace-name upstream-core-lon04 --name "^7300" --all
+`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if _, err := regexp.Compile(pkg.ImageCMDOptions.StockImageName); err != nil {
+			return fmt.Errorf("invalid --name regular expression, err: %v", err)
+		}
+		return client.EnsureWorkspace(&pkg.ImageCMDOptions.WorkspaceID, &pkg.ImageCMDOptions.WorkspaceName)
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opt := pkg.ImageCMDOptions
+
+		pvsClient, err := client.NewClientWithEnv(pkg.Options.APIKey, pkg.Options.Environment, pkg.Options.Debug)
+		if err != nil {
+			return err
+		}
+		pvmclient, err := client.NewPVMClientWithEnv(pvsClient, opt.WorkspaceID, opt.WorkspaceName, pkg.Options.Environment)
+		if err != nil {
+			return err
+		}
+
+		stock, err := pvmclient.ImgClient.GetAllStockImages(opt.IncludeSAP, opt.IncludeVTL)
+		if err != nil {
+			return fmt.Errorf("failed to list the stock images, err: %v", err)
+		}
+		matched := matchImages(stock.Images, opt.StockImageName)
+		if len(matched) == 0 {
+			return fmt.Errorf("no stock images found matching the name %q", opt.StockImageName)
+		}
+
+		selected := matched
+		if len(matched) > 1 && !opt.ImportAll {
+			if !utils.IsInteractive() {
+				return fmt.Errorf("%d stock images match the name %q, pass a more specific --name or --all to import all of them", len(matched), opt.StockImageName)
+			}
+			if selected, err = selectImage(matched); err != nil {
+				return err
+			}
+		}
+
+		var errs []error
+		for _, img := range selected {
+			if err := importImage(pvmclient, img, opt.WatchTimeout); err != nil {
+				klog.Errorf("failed to import the stock image %s, err: %v", *img.Name, err)
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	},
+}
+
+func init() {
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.WorkspaceName, "workspace-name", "", "PowerVS Workspace name.")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.WorkspaceID, "workspace-id", "", "PowerVS Workspace ID or CRN.")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.StockImageName, "name", "", "Regular expression matching the name of the stock images to import.")
+	Cmd.Flags().BoolVar(&pkg.ImageCMDOptions.ImportAll, "all", false, "Import all the matched stock images without prompting.")
+	Cmd.Flags().BoolVar(&pkg.ImageCMDOptions.IncludeSAP, "include-sap", false, "Include the SAP images from the catalog.")
+	Cmd.Flags().BoolVar(&pkg.ImageCMDOptions.IncludeVTL, "include-vtl", false, "Include the VTL images from the catalog.")
+	Cmd.Flags().DurationVar(&pkg.ImageCMDOptions.WatchTimeout, "watch-timeout", 1*time.Hour, "watch timeout")
+	_ = Cmd.MarkFlagRequired("name")
+	Cmd.Flags().SortFlags = false
+}
+
+// matchImages returns the images with the name matching the regular expression sorted by the name
+func matchImages(images []*pmodels.ImageReference, expr string) []*pmodels.ImageReference {
+	r := regexp.MustCompile(expr)
+	var matched []*pmodels.ImageReference
+	for _, img := range images {
+		if r.MatchString(*img.Name) {
+			matched = append(matched, img)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool { return *matched[i].Name < *matched[j].Name })
+	return matched
+}
+
+// describe returns the name of the image along with the operating system for the selection
+func describe(img *pmodels.ImageReference) string {
+	if img.Specifications != nil && img.Specifications.OperatingSystem != "" {
+		return fmt.Sprintf("%s (%s)", *img.Name, img.Specifications.OperatingSystem)
+	}
+	return *img.Name
+}
+
+// selectImage prompts the user to select one of the matched images, the images are told apart by the ID as the names
+// may repeat across the catalogs
+func selectImage(images []*pmodels.ImageReference) ([]*pmodels.ImageReference, error) {
+	var options []utils.Option
+	byID := map[string]*pmodels.ImageReference{}
+	for _, img := range images {
+		options = append(options, utils.Option{Label: fmt.Sprintf("%s, ID: %s", describe(img), *img.ImageID), Value: *img.ImageID})
+		byID[*img.ImageID] = img
+	}
+	id, err := utils.SelectOption("Select the stock image to import:", options, "")
+	if err != nil {
+		return nil, err
+	}
+	return []*pmodels.ImageReference{byID[id]}, nil
+}
+
+// importImage copies the stock image into the workspace and waits for it to be active
+func importImage(pvmclient *client.PVMClient, img *pmodels.ImageReference, timeout time.Duration) error {
+	existing, err := pvmclient.ImgClient.GetImageByName(*img.Name)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		klog.Infof("Image %s already exists in the workspace %s with ID: %s", *img.Name, pvmclient.InstanceName, *existing.ImageID)
+		return nil
+	}
+
+	klog.Infof("Importing the stock image %s into the workspace %s. Please wait...", *img.Name, pvmclient.InstanceName)
+	entry := audit.Entry{Name: "images", Operation: "import", Workspace: pvmclient.InstanceID, Value: *img.Name + " <- stock:" + *img.ImageID}
+	image, err := pvmclient.ImgClient.CopyStockImage(*img.ImageID)
+	if err != nil {
+		audit.Record(entry, err)
+		return err
+	}
+	entry.ResourceID = *image.ImageID
+	start := time.Now()
+	err = utils.SpinnerPollUntil(time.Tick(10*time.Second), time.After(timeout), func() (string, bool, error) {
+		i, err := pvmclient.ImgClient.Get(*image.ImageID)
+		if err != nil {
+			return "", false, fmt.Errorf("failed to import the image, err: %v\n\nRun the command \"pvsadm get events -i %s\" to get more information about the failure", err, pvmclient.InstanceID)
+		}
+		if i.State == imageStateActive {
+			klog.Infof("Successfully imported the image: %s with ID: %s Total time taken: %s", *img.Name, *image.ImageID, time.Since(start).Round(time.Second))
+			return "", true, nil
+		}
+		return fmt.Sprintf("Waiting for image to be active. Current state: %s", i.State), false, nil
+	})
+	audit.Record(entry, err)
+	return err
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importstock
+
+import (
+	"testing"
+
+	pmodels "github.com/IBM-Cloud/power-go-client/power/models"
+	"k8s.io/utils/ptr"
+)
+
+func Test_matchImages(t *testing.T) {
+	images := []*pmodels.ImageReference{
+		{Name: ptr.To("RHEL9-SP2"), Specifications: &pmodels.ImageSpecifications{OperatingSystem: "rhel"}},
+		{Name: ptr.To("7300-01-02")},
+		{Name: ptr.To("7300-00-01")},
+		{Name: ptr.To("IBMi-75-03-2924-1")},
+	}
+	tests := []struct {
+		expr string
+		want []string
+	}{
+		{"^7300", []string{"7300-00-01", "7300-01-02"}},
+		{"RHEL9", []string{"RHEL9-SP2"}},
+		{"SLES", nil},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, img := range matchImages(images, tt.expr) {
+			got = append(got, *img.Name)
+		}
+		if len(got) != len(tt.want) {
+			t.Fatalf("matchImages(%s) = %v, want %v", tt.expr, got, tt.want)
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("matchImages(%s) = %v, want %v", tt.expr, got, tt.want)
+			}
+		}
+	}
+	if got := describe(images[0]); got != "RHEL9-SP2 (rhel)" {
+		t.Errorf("describe() = %v", got)
+	}
+}
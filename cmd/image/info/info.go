@@ -15,17 +15,44 @@
 package info
 
 import (
-	"encoding/xml"
+	"encoding/json"
 	"fmt"
-	"io"
 	"os"
-	"path/filepath"
+	"strconv"
+	"strings"
 
-	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
 	"github.com/spf13/cobra"
 	"k8s.io/klog/v2"
+
+	"github.com/ppc64le-cloud/pvsadm/cmd/image/qcow2ova/ova"
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
 )
 
+// imageInfo is the metadata of the OVA image
+type imageInfo struct {
+	File               string          `json:"file"`
+	OVFName            string          `json:"ovfName"`
+	Name               string          `json:"name"`
+	OSID               string          `json:"osId"`
+	OSDescription      string          `json:"osDescription"`
+	Architecture       string          `json:"architecture"`
+	BootVolume         string          `json:"bootVolume"`
+	BootVolumeSize     int64           `json:"bootVolumeSize"`
+	BootVolumeCapacity int64           `json:"bootVolumeCapacity"`
+	Compressed         bool            `json:"compressed"`
+	CompressedSize     int64           `json:"compressedSize,omitempty"`
+	UncompressedSize   int64           `json:"uncompressedSize"`
+	PvsadmVersion      string          `json:"pvsadmVersion,omitempty"`
+	Provenance         *ova.Provenance `json:"provenance,omitempty"`
+	Files              []fileInfo      `json:"files"`
+}
+
+type fileInfo struct {
+	Name string `json:"name"`
+	Size int64  `json:"size"`
+}
+
 var Cmd = &cobra.Command{
 	Use:   "info",
 	Short: "Provide info about the image",
@@ -33,10 +60,19 @@ var Cmd = &cobra.Command{
 pvsadm image info --help for information
 
 Examples:
-# To get the pvsadm tool version by specifying the image
+# To get the image details like the OS, boot volume, sizes and the build provenance
 pvsadm image info rhcos-46-12152021.ova.gz
 
+# To get the image details in the json format
+pvsadm image info rhcos-46-12152021.ova.gz -o json
+
 `,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if !utils.Contains([]string{"table", "json"}, pkg.ImageCMDOptions.Output) {
+			return fmt.Errorf("unsupported output format %q, supported: table, json", pkg.ImageCMDOptions.Output)
+		}
+		return nil
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 
 		if len(args) == 0 {
@@ -44,73 +80,129 @@ pvsadm image info rhcos-46-12152021.ova.gz
 		}
 
 		fileName := args[0]
-		var ova string
-		ovaImgDir, err := os.MkdirTemp(os.TempDir(), "ova-img-dir")
+		archive, err := ova.ReadArchive(fileName)
 		if err != nil {
 			return err
 		}
-
-		defer os.RemoveAll(ovaImgDir)
-
-		//Check if the image is in gzip format and unzip it.
-		checkGzip, err := utils.IsGzip(fileName)
-		if err != nil {
-			return fmt.Errorf("failed to detect the image filetype: %v", err)
-		}
-		if checkGzip {
-			klog.V(1).Infof("Image %s is in gzip format, extracting it", fileName)
-			ova = filepath.Join(ovaImgDir, "image.ova")
-			err = utils.GunzipIt(fileName, ova)
-			if err != nil {
-				return err
-			}
-			klog.V(1).Info("Extract complete")
-		} else {
-			ova = fileName
-		}
-
-		//Extract the ovf file
-		err = utils.Untar(ova, ovaImgDir, "*.ovf")
+		envelope, err := ova.ParseOVF(archive.OVF)
 		if err != nil {
 			return err
 		}
-		ovfFilePath := ovaImgDir + "/*.ovf"
-		matches, err := filepath.Glob(ovfFilePath)
-		if err != nil {
-			return err
-		}
-		if len(matches) == 0 {
-			return fmt.Errorf(".ovf file is not found in %s image", fileName)
+		info := getInfo(fileName, archive, envelope)
+
+		if pkg.ImageCMDOptions.Output == "json" {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			return enc.Encode(info)
 		}
-		filename := matches[0]
 
-		type Ovf struct {
-			Pvsadmversionsection struct {
-				Info        string `xml:"Info"`
-				BuildNumber string `xml:"BuildNumber"`
-			} `xml:"pvsadmversionsection"`
+		if info.PvsadmVersion == "" {
+			klog.Warning("unable to find the pvsadm tool version")
 		}
+		printTable(info)
+		return nil
+	},
+}
 
-		xmlFile, err := os.Open(filename)
-		if err != nil {
-			return err
+func init() {
+	Cmd.Flags().StringVarP(&pkg.ImageCMDOptions.Output, "output", "o", "table", "Output format, supported: table, json")
+}
+
+// getInfo collects the image metadata from the archive and the OVF descriptor
+func getInfo(fileName string, archive *ova.Archive, envelope *ova.Envelope) *imageInfo {
+	info := &imageInfo{
+		File:             fileName,
+		OVFName:          archive.OVFName,
+		Name:             envelope.Collection.Name,
+		Compressed:       archive.Compressed,
+		UncompressedSize: archive.Size,
+		PvsadmVersion:    envelope.PvsadmVersion.BuildNumber,
+	}
+	if archive.Compressed {
+		info.CompressedSize = archive.CompressedSize
+	}
+	if envelope.Provenance != (ova.Provenance{}) {
+		p := envelope.Provenance
+		info.Provenance = &p
+	}
+	for _, name := range archive.Names {
+		info.Files = append(info.Files, fileInfo{name, archive.Files[name]})
+	}
+
+	// The disk attached with the boot flag is the boot volume, falls back to the first disk
+	diskID := ""
+	if len(envelope.Disks) > 0 {
+		diskID = envelope.Disks[0].DiskID
+	}
+	if len(envelope.Collection.VirtualSystems) > 0 {
+		vs := envelope.Collection.VirtualSystems[0]
+		if info.Name == "" {
+			info.Name = vs.Name
 		}
-		defer xmlFile.Close()
-		var version Ovf
-		byteValue, err := io.ReadAll(xmlFile)
-		if err != nil {
-			return err
+		info.OSID = vs.OperatingSystem.ID
+		info.OSDescription = vs.OperatingSystem.Description
+		info.Architecture = vs.OperatingSystem.Architecture
+		for _, item := range vs.Items {
+			if boot, _ := strconv.ParseBool(item.Boot); boot {
+				info.BootVolume = item.ElementName
+				diskID = strings.TrimPrefix(item.HostResource, "ovf:/disk/")
+				break
+			}
 		}
-		err = xml.Unmarshal(byteValue, &version)
-		if err != nil {
-			return err
+	}
+	for _, d := range envelope.Disks {
+		if d.DiskID != diskID {
+			continue
 		}
-		toolversion := version.Pvsadmversionsection.BuildNumber
-		if toolversion == "" {
-			klog.Warning("unable to find the pvsadm tool version")
-			return nil
+		if capacity, err := d.CapacityBytes(); err == nil {
+			info.BootVolumeCapacity = capacity
+		} else {
+			klog.Warningf("failed to get the capacity of the boot volume, err: %v", err)
 		}
-		klog.Infof("Pvsadm tool version used for creating this image: %+v", toolversion)
-		return nil
-	},
+		if f, ok := envelope.File(d.FileRef); ok {
+			info.BootVolumeSize = f.Size
+			if info.BootVolume == "" {
+				info.BootVolume = f.Href
+			}
+		}
+	}
+	return info
+}
+
+func printTable(info *imageInfo) {
+	table := utils.NewTable()
+	table.SetHeader([]string{"Property", "Value"})
+	rows := [][]string{
+		{"File", info.File},
+		{"OVF", info.OVFName},
+		{"Name", info.Name},
+		{"OS ID", info.OSID},
+		{"OS Description", info.OSDescription},
+		{"Architecture", info.Architecture},
+		{"Boot Volume", info.BootVolume},
+		{"Boot Volume Size", utils.FormatBytes(info.BootVolumeSize)},
+		{"Boot Volume Capacity", utils.FormatBytes(info.BootVolumeCapacity)},
+	}
+	if info.Compressed {
+		rows = append(rows, []string{"Compressed Size", utils.FormatBytes(info.CompressedSize)})
+	}
+	rows = append(rows,
+		[]string{"Uncompressed Size", utils.FormatBytes(info.UncompressedSize)},
+		[]string{"Pvsadm Version", info.PvsadmVersion},
+	)
+	if p := info.Provenance; p != nil {
+		rows = append(rows,
+			[]string{"Source URL", p.SourceURL},
+			[]string{"Source Checksum", p.SourceChecksum},
+			[]string{"Distro", p.Distro},
+			[]string{"Prep Template Hash", p.PrepTemplateHash},
+			[]string{"Cloud Config Hash", p.CloudConfigHash},
+			[]string{"Build Host", p.BuildHost},
+			[]string{"Build Timestamp", p.BuildTimestamp},
+		)
+	}
+	for _, row := range rows {
+		table.Append(row)
+	}
+	table.Table.Render()
 }
@@ -0,0 +1,76 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package info
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ppc64le-cloud/pvsadm/cmd/image/qcow2ova/ova"
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+func Test_getInfo(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "rhel-disk.raw"), make([]byte, 4096), 0644); err != nil {
+		t.Fatal(err)
+	}
+	provenance := ova.Provenance{
+		SourceURL:      "https://example.com/images/rhel.qcow2?a=b&c=d",
+		SourceChecksum: "sha256:abcd",
+		Distro:         "rhel",
+		BuildHost:      "builder",
+		BuildTimestamp: "2026-01-02T03:04:05Z",
+	}
+	ovaFile := filepath.Join(t.TempDir(), "rhel.ova")
+	if err := ova.CreateTarArchive(dir, ovaFile, 120, "rhel", "rhel-disk.raw", provenance); err != nil {
+		t.Fatal(err)
+	}
+	gzFile := ovaFile + ".gz"
+	if err := utils.GzipIt(ovaFile, gzFile); err != nil {
+		t.Fatal(err)
+	}
+
+	archive, err := ova.ReadArchive(gzFile)
+	if err != nil {
+		t.Fatalf("ReadArchive() error = %v", err)
+	}
+	envelope, err := ova.ParseOVF(archive.OVF)
+	if err != nil {
+		t.Fatalf("ParseOVF() error = %v", err)
+	}
+	info := getInfo(gzFile, archive, envelope)
+
+	ovaInfo, err := os.Stat(ovaFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Name != "rhel.ova" || info.OVFName != "coreos.ovf" || info.OSID != "79" || info.Architecture != "ppc64le" {
+		t.Errorf("getInfo() = %+v", info)
+	}
+	if info.BootVolume != "rhel-disk.raw" || info.BootVolumeSize != 4096 || info.BootVolumeCapacity != 120*1024*1024*1024 {
+		t.Errorf("getInfo() boot volume = %s, size: %d, capacity: %d", info.BootVolume, info.BootVolumeSize, info.BootVolumeCapacity)
+	}
+	if !info.Compressed || info.UncompressedSize != ovaInfo.Size() {
+		t.Errorf("getInfo() compressed = %v, uncompressed size = %d, want %d", info.Compressed, info.UncompressedSize, ovaInfo.Size())
+	}
+	if info.Provenance == nil || *info.Provenance != provenance {
+		t.Errorf("getInfo() provenance = %+v, want %+v", info.Provenance, provenance)
+	}
+	if len(info.Files) != 3 {
+		t.Errorf("getInfo() files = %+v", info.Files)
+	}
+}
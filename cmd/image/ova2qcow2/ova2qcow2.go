@@ -0,0 +1,126 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ova2qcow2
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+
+	"github.com/ppc64le-cloud/pvsadm/cmd/image/qcow2ova/ova"
+	"github.com/ppc64le-cloud/pvsadm/cmd/image/qcow2ova/qemuimg"
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "ova2qcow2 <image.ova.gz>",
+	Short: "Convert the ova image to qcow2 format",
+	Long: `Convert the PowerVS ova image to qcow2 format for booting locally under the KVM, e.g: for debugging the image
+pvsadm image ova2qcow2 --help for information
+
+Examples:
+# Convert the image generated by the qcow2ova command into rhel-86.qcow2
+pvsadm image ova2qcow2 rhel-86.ova.gz
+
+# Convert the image into the compressed qcow2 image at the user defined location
+pvsadm image ova2qcow2 rhel-86.ova.gz --output-file /var/lib/libvirt/images/rhel-86.qcow2 --compress
+`,
+	Args: cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if _, err := exec.LookPath(qemuimg.QemuCMD); err != nil {
+			return fmt.Errorf("%s command is not found, install it with: yum install qemu-img -y", qemuimg.QemuCMD)
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opt := pkg.ImageCMDOptions
+		file := args[0]
+
+		target := opt.OutputFile
+		if target == "" {
+			target = defaultTarget(file)
+		}
+		if _, err := os.Stat(target); err == nil {
+			return fmt.Errorf("%s already exists", target)
+		}
+
+		archive, err := ova.ReadArchive(file)
+		if err != nil {
+			return err
+		}
+		envelope, err := ova.ParseOVF(archive.OVF)
+		if err != nil {
+			return err
+		}
+		disk, ok := envelope.DiskFile()
+		if !ok {
+			return fmt.Errorf("disk is not found in the %s image", file)
+		}
+
+		tmpDir, err := os.MkdirTemp(opt.TempDir, "ova2qcow2")
+		if err != nil {
+			return fmt.Errorf("failed to create a temporary directory: %v", err)
+		}
+		defer os.RemoveAll(tmpDir)
+
+		tarball := file
+		if archive.Compressed {
+			klog.Infof("Extracting the %s image", file)
+			tarball = filepath.Join(tmpDir, "image.ova")
+			if err := utils.GunzipIt(file, tarball); err != nil {
+				return err
+			}
+		}
+
+		klog.Infof("Extracting the disk %s", disk.Href)
+		if err := utils.Untar(tarball, tmpDir, disk.Href); err != nil {
+			return fmt.Errorf("failed to extract the disk %s, err: %v", disk.Href, err)
+		}
+		rawImg := filepath.Join(tmpDir, disk.Href)
+
+		var convertOpts []string
+		if opt.CompressQcow2 {
+			convertOpts = append(convertOpts, "-c")
+		}
+		klog.Infof("Converting raw(%s) image to qcow2(%s) format", rawImg, target)
+		if err := qemuimg.Convert(rawImg, "raw", target, "qcow2", convertOpts...); err != nil {
+			return err
+		}
+		klog.Info("Conversion completed")
+
+		fmt.Printf("\n\nSuccessfully converted OVA image to qcow2 format, find at %s\n", target)
+		return nil
+	},
+}
+
+func init() {
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.OutputFile, "output-file", "", "Path of the resultant qcow2 image(default: <image name>.qcow2 in the current directory)")
+	Cmd.Flags().BoolVar(&pkg.ImageCMDOptions.CompressQcow2, "compress", false, "Compress the resultant qcow2 image")
+	Cmd.Flags().StringVarP(&pkg.ImageCMDOptions.TempDir, "temp-dir", "t", os.TempDir(), "Scratch space to use for extracting the image")
+}
+
+// defaultTarget returns the qcow2 image name derived from the ova image name in the current directory
+func defaultTarget(file string) string {
+	name := filepath.Base(file)
+	name = strings.TrimSuffix(name, ".gz")
+	name = strings.TrimSuffix(name, ".ova")
+	return name + ".qcow2"
+}
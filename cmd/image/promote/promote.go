@@ -0,0 +1,289 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package promote
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	"github.com/ppc64le-cloud/pvsadm/cmd/image/export"
+	imagesync "github.com/ppc64le-cloud/pvsadm/cmd/image/sync"
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/audit"
+	"github.com/ppc64le-cloud/pvsadm/pkg/client"
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+// cloudRegions maps the IBM Cloud regions of the PowerVS datacenters(e.g: dal, wdc) to the Cloud Object Storage
+// regions used for importing the images, the workspaces in the other regions require the --target-region option
+var cloudRegions = map[string]string{
+	"dal": "us-south",
+	"wdc": "us-east",
+	"sao": "br-sao",
+	"tor": "ca-tor",
+	"mon": "ca-tor",
+	"lon": "eu-gb",
+	"fra": "eu-de",
+	"mad": "eu-es",
+	"syd": "au-syd",
+	"tok": "jp-tok",
+	"osa": "jp-osa",
+}
+
+// target is the workspace the image is promoted to
+type target struct {
+	pvmclient *client.PVMClient
+	region    string
+	bucket    string
+}
+
+var Cmd = &cobra.Command{
+	Use:   "promote",
+	Short: "Promote the image from one workspace to other workspaces across the regions",
+	Long: `Promote the image from one workspace to other workspaces across the regions
+pvsadm image promote --help for information
+
+The image is exported from the source workspace to the bucket(or the already exported object is reused with --object),
+replicated to the bucket in the region of each target workspace and imported into the target workspaces with the
+original name and the storage tier. The promotion is recorded in the audit log.
+
+All the buckets must belong to the Cloud Object Storage instance set with the --cos-instance-name, the HMAC keys of the
+instance are used for exporting and importing the image. The region of the target workspace is derived from the
+datacenter of its zone, use --target-region to set it explicitly.
+
+# Set the API key or feed the --api-key commandline argument
+export IBMCLOUD_APIKEY=<IBMCLOUD_APIKEY>
+
+Examples:
+
+# Promote the image from the golden workspace in the us-south to the workspaces in the us-south and eu-de regions
+pvsadm image promote --image rhel-86 --from-workspace golden-dal10 --to-workspace prod-dal12,prod-fra --cos-instance-name images-cos -b images-us-south -r us-south --target-bucket eu-de=images-eu-de --accesskey <ACCESSKEY> --secretkey <SECRETKEY>
+
+# Promote the image reusing the object already exported to the bucket
+pvsadm image promote --image rhel-86 --object rhel-86.ova.gz --from-workspace golden-dal10 --to-workspace prod-lon04 --cos-instance-name images-cos -b images-us-south -r us-south --target-bucket eu-gb=images-eu-gb --accesskey <ACCESSKEY> --secretkey <SECRETKEY>
+`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if len(pkg.ImageCMDOptions.ToWorkspaces) == 0 {
+			return fmt.Errorf("--to-workspace is required")
+		}
+		return utils.EnsurePrerequisitesAreSet("", pkg.ImageCMDOptions.FromWorkspace)
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opt := pkg.ImageCMDOptions
+
+		pvsClient, err := client.NewClientWithEnv(pkg.Options.APIKey, pkg.Options.Environment, pkg.Options.Debug)
+		if err != nil {
+			return err
+		}
+		source, err := client.NewPVMClientWithEnv(pvsClient, "", opt.FromWorkspace, pkg.Options.Environment)
+		if err != nil {
+			return err
+		}
+		imageID, imageName, err := export.FindImage(source, opt.ExportImage)
+		if err != nil {
+			return err
+		}
+		image, err := source.ImgClient.Get(imageID)
+		if err != nil {
+			return fmt.Errorf("failed to get the image %s, err: %v", imageName, err)
+		}
+
+		// Resolve the targets before exporting the image to fail early
+		var targets []target
+		for _, ws := range opt.ToWorkspaces {
+			pvmclient, err := client.NewPVMClientWithEnv(pvsClient, "", ws, pkg.Options.Environment)
+			if err != nil {
+				return err
+			}
+			region, ok := opt.TargetRegions[pvmclient.InstanceName]
+			if !ok {
+				if region, err = zoneRegion(pvmclient); err != nil {
+					return err
+				}
+			}
+			bucket, err := targetBucket(region, opt.Region, opt.BucketName, opt.TargetBuckets)
+			if err != nil {
+				return err
+			}
+			targets = append(targets, target{pvmclient, region, bucket})
+		}
+
+		s3Client, err := client.NewS3Client(pvsClient, opt.COSInstanceName, opt.Region)
+		if err != nil {
+			return err
+		}
+		object := opt.ImageFilename
+		if object != "" {
+			exists, err := s3Client.CheckIfObjectExists(opt.BucketName, object)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return fmt.Errorf("object %s not found in the bucket %s", object, opt.BucketName)
+			}
+			klog.Infof("Reusing the object %s from the bucket %s", object, opt.BucketName)
+		} else {
+			klog.Infof("Exporting the image %s to the bucket %s. Please wait...", imageName, opt.BucketName)
+			jobRef, err := source.ImgClient.ExportImage(imageID, opt.BucketName, opt.Region, opt.AccessKey, opt.SecretKey)
+			if err != nil {
+				return fmt.Errorf("failed to export the image %s, err: %v", imageName, err)
+			}
+			if err := source.JobClient.WaitForCompletion(*jobRef.ID, "image export", 30*time.Second, opt.WatchTimeout); err != nil {
+				return err
+			}
+			if object, err = export.FindObject(s3Client, opt.BucketName, imageName); err != nil {
+				return err
+			}
+			audit.Record(audit.Entry{Name: "images", Operation: "export", Workspace: source.InstanceID, ResourceID: imageID, Value: source.InstanceName + ":" + imageName + " -> " + opt.BucketName + "/" + object}, nil)
+		}
+
+		if spec := syncSpec(opt.COSInstanceName, opt.BucketName, opt.Region, opt.StorageClass, object, targets); len(spec[0].Target) > 0 {
+			klog.Infof("Replicating the object %s to the target buckets", object)
+			if err := imagesync.Sync(pvsClient, spec); err != nil {
+				return err
+			}
+		}
+
+		var errs []error
+		for _, t := range targets {
+			id, err := importImage(t, imageName, ptr.Deref(image.StorageType, ""), object)
+			audit.Record(audit.Entry{Name: "images", Operation: "promote", Workspace: t.pvmclient.InstanceID, ResourceID: id, Value: fmt.Sprintf("%s:%s(%s) -> %s:%s (object: %s/%s)", source.InstanceName, imageName, imageID, t.pvmclient.InstanceName, imageName, t.bucket, object)}, err)
+			if err != nil {
+				klog.Errorf("failed to promote the image %s to the workspace %s, err: %v", imageName, t.pvmclient.InstanceName, err)
+				errs = append(errs, fmt.Errorf("%s: %v", t.pvmclient.InstanceName, err))
+				continue
+			}
+			klog.Infof("Successfully promoted the image %s to the workspace %s", imageName, t.pvmclient.InstanceName)
+		}
+		if len(errs) != 0 {
+			return fmt.Errorf("failed to promote the image %s, err: %v", imageName, errors.Join(errs...))
+		}
+		return nil
+	},
+}
+
+func init() {
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.ExportImage, "image", "", "Name or ID of the PowerVS image to promote.")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.FromWorkspace, "from-workspace", "", "Name of the PowerVS workspace to promote the image from.")
+	Cmd.Flags().StringSliceVar(&pkg.ImageCMDOptions.ToWorkspaces, "to-workspace", nil, "Names of the PowerVS workspaces to promote the image to.")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.COSInstanceName, "cos-instance-name", "", "Cloud Object Storage instance name of the buckets.")
+	Cmd.Flags().StringVarP(&pkg.ImageCMDOptions.BucketName, "bucket", "b", "", "Cloud Object Storage bucket to export the image to.")
+	Cmd.Flags().StringVarP(&pkg.ImageCMDOptions.Region, "bucket-region", "r", "", "Cloud Object Storage bucket location.")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.StorageClass, "storage-class", "smart", "Cloud Object Storage storage class of the buckets.")
+	Cmd.Flags().StringToStringVar(&pkg.ImageCMDOptions.TargetBuckets, "target-bucket", nil, "Bucket in the region of the target workspaces in <region>=<bucket> format, the --bucket is used for the workspaces in the --bucket-region.")
+	Cmd.Flags().StringToStringVar(&pkg.ImageCMDOptions.TargetRegions, "target-region", nil, "Cloud Object Storage region of the target workspace in <workspace>=<region> format(default: derived from the zone of the workspace).")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.ImageFilename, "object", "", "Already exported object of the image in the --bucket, the image is exported if not set.")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.AccessKey, "accesskey", "", "Cloud Object Storage HMAC access key.")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.SecretKey, "secretkey", "", "Cloud Object Storage HMAC secret key.")
+	Cmd.Flags().DurationVar(&pkg.ImageCMDOptions.WatchTimeout, "watch-timeout", 1*time.Hour, "watch timeout")
+	_ = Cmd.MarkFlagRequired("image")
+	_ = Cmd.MarkFlagRequired("from-workspace")
+	_ = Cmd.MarkFlagRequired("cos-instance-name")
+	_ = Cmd.MarkFlagRequired("bucket")
+	_ = Cmd.MarkFlagRequired("bucket-region")
+	_ = Cmd.MarkFlagRequired("accesskey")
+	_ = Cmd.MarkFlagRequired("secretkey")
+	Cmd.Flags().SortFlags = false
+}
+
+// zoneRegion returns the Cloud Object Storage region of the workspace derived from the datacenter of its zone
+func zoneRegion(pvmclient *client.PVMClient) (string, error) {
+	dc, err := pvmclient.DatacenterClient.Get(pvmclient.Zone)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the datacenter %s of the workspace %s, err: %v", pvmclient.Zone, pvmclient.InstanceName, err)
+	}
+	var cloudRegion string
+	if dc.Location != nil {
+		cloudRegion = dc.Location.CloudRegion
+	}
+	return targetRegion(pvmclient.InstanceName, pvmclient.Zone, cloudRegion)
+}
+
+// targetRegion returns the Cloud Object Storage region for the workspace in the IBM Cloud region
+func targetRegion(workspace, zone, cloudRegion string) (string, error) {
+	if region, ok := cloudRegions[cloudRegion]; ok {
+		return region, nil
+	}
+	return "", fmt.Errorf("unable to derive the region of the workspace %s in the zone %s, set it with --target-region %s=<region>", workspace, zone, workspace)
+}
+
+// targetBucket returns the bucket in the region, the source bucket is used for the source region
+func targetBucket(region, srcRegion, srcBucket string, buckets map[string]string) (string, error) {
+	if bucket, ok := buckets[region]; ok {
+		return bucket, nil
+	}
+	if region == srcRegion {
+		return srcBucket, nil
+	}
+	return "", fmt.Errorf("bucket is not set for the region %s, set it with --target-bucket %s=<bucket>", region, region)
+}
+
+// syncSpec returns the spec replicating the object to the target buckets other than the source bucket
+func syncSpec(cos, bucket, region, storageClass, object string, targets []target) []pkg.Spec {
+	spec := pkg.Spec{
+		Source: pkg.Source{
+			Bucket:       bucket,
+			Cos:          cos,
+			Object:       "^" + regexp.QuoteMeta(object) + "$",
+			StorageClass: storageClass,
+			Region:       region,
+		},
+	}
+	seen := map[string]bool{bucket: true}
+	for _, t := range targets {
+		if seen[t.bucket] {
+			continue
+		}
+		seen[t.bucket] = true
+		spec.Target = append(spec.Target, pkg.TargetItem{Bucket: t.bucket, StorageClass: storageClass, Region: t.region})
+	}
+	return []pkg.Spec{spec}
+}
+
+// importImage imports the object into the target workspace with the name and the storage type, returns the ID of the
+// image once the import job is completed
+func importImage(t target, name, storageType, object string) (string, error) {
+	opt := pkg.ImageCMDOptions
+	existing, err := t.pvmclient.ImgClient.GetImageByName(name)
+	if err != nil {
+		return "", err
+	}
+	if existing != nil {
+		return "", fmt.Errorf("image %s already exists", name)
+	}
+	klog.Infof("Importing the image %s into the workspace %s. Please wait...", name, t.pvmclient.InstanceName)
+	jobRef, err := t.pvmclient.ImgClient.ImportImage(name, object, t.region, opt.AccessKey, opt.SecretKey, t.bucket, strings.ToLower(storageType), "private")
+	if err != nil {
+		return "", err
+	}
+	if err := t.pvmclient.JobClient.WaitForCompletion(*jobRef.ID, "image import", 30*time.Second, opt.WatchTimeout); err != nil {
+		return "", err
+	}
+	imported, err := t.pvmclient.ImgClient.GetImageByName(name)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the imported image %s, err: %v", name, err)
+	}
+	if imported == nil {
+		return "", fmt.Errorf("image %s not found after the import", name)
+	}
+	return ptr.Deref(imported.ImageID, ""), nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package promote
+
+import (
+	"testing"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg/client"
+)
+
+func Test_targetRegionAndBucket(t *testing.T) {
+	tests := []struct {
+		name        string
+		workspace   string
+		cloudRegion string
+		wantRegion  string
+		wantBucket  string
+		wantErr     bool
+	}{
+		{name: "source region", workspace: "prod-dal12", cloudRegion: "dal", wantRegion: "us-south", wantBucket: "images-us-south"},
+		{name: "target bucket", workspace: "prod-fra", cloudRegion: "fra", wantRegion: "eu-de", wantBucket: "images-eu-de"},
+		{name: "unknown region", workspace: "prod-x", cloudRegion: "xyz", wantErr: true},
+		{name: "region not set", workspace: "prod-x", wantErr: true},
+		{name: "missing bucket", workspace: "prod-lon", cloudRegion: "lon", wantErr: true},
+	}
+	buckets := map[string]string{"eu-de": "images-eu-de"}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			region, err := targetRegion(tt.workspace, "zone", tt.cloudRegion)
+			var bucket string
+			if err == nil {
+				bucket, err = targetBucket(region, "us-south", "images-us-south", buckets)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (region != tt.wantRegion || bucket != tt.wantBucket) {
+				t.Errorf("got region: %s, bucket: %s, want region: %s, bucket: %s", region, bucket, tt.wantRegion, tt.wantBucket)
+			}
+		})
+	}
+}
+
+func Test_syncSpec(t *testing.T) {
+	ws := &client.PVMClient{InstanceName: "ws"}
+	targets := []target{
+		{ws, "us-south", "images-us-south"},
+		{ws, "eu-de", "images-eu-de"},
+		{ws, "eu-de", "images-eu-de"},
+	}
+	spec := syncSpec("images-cos", "images-us-south", "us-south", "smart", "rhel-86.ova.gz", targets)
+	if len(spec) != 1 || spec[0].Source.Object != `^rhel-86\.ova\.gz$` {
+		t.Fatalf("syncSpec() = %+v", spec)
+	}
+	if len(spec[0].Target) != 1 || spec[0].Target[0].Bucket != "images-eu-de" || spec[0].Target[0].Region != "eu-de" {
+		t.Errorf("syncSpec() targets = %+v, want only the images-eu-de bucket", spec[0].Target)
+	}
+}
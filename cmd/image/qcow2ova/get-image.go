@@ -15,28 +15,71 @@
 package qcow2ova
 
 import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"os"
 	"path"
+	"strings"
 	"time"
 
+	"github.com/vbauerster/mpb/v8"
+	"github.com/vbauerster/mpb/v8/decor"
 	"k8s.io/klog/v2"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/cache"
+	"github.com/ppc64le-cloud/pvsadm/pkg/client"
 )
 
 const (
 	DefaultGetTimeout = 30 * time.Minute
 )
 
+var (
+	// downloadRetries is the number of times an interrupted download is resumed before giving up
+	downloadRetries = 5
+	// retryInterval is the wait time between the download attempts
+	retryInterval = 5 * time.Second
+	// checksumFiles are the well known checksum files published next to the images by the distros
+	checksumFiles = []string{"CHECKSUM", "SHA256SUMS"}
+	// bucketRegion is the region of the Cloud Object Storage bucket of the s3:// and cos:// image url
+	bucketRegion string
+)
+
 // Downloads or copy the image into the target dir mentioned
 func getImage(downloadDir string, srcUrl string, timeout time.Duration) (string, error) {
 	if timeout == 0 {
 		timeout = DefaultGetTimeout
 	}
 	dest := path.Join(downloadDir, path.Base(srcUrl))
-	if !isURL(srcUrl) {
+	checksum, err := expectedChecksum(srcUrl, timeout)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case isCOSURL(srcUrl):
+		klog.V(1).Infof("Downloading %s into %s", srcUrl, dest)
+		if err := downloadCOSObject(srcUrl, dest); err != nil {
+			return "", err
+		}
+		klog.V(1).Info("Download Completed!")
+		if err := verifyChecksum(dest, checksum); err != nil {
+			return "", err
+		}
+	case isURL(srcUrl):
+		httpClient, err := newHTTPClient(timeout)
+		if err != nil {
+			return "", err
+		}
+		if err := fetch(httpClient, srcUrl, dest, checksum); err != nil {
+			return "", err
+		}
+	default:
 		if !fileExists(srcUrl) {
 			return "", fmt.Errorf("not a valid URL or file does not exist at %s", srcUrl)
 		}
@@ -45,33 +88,383 @@ func getImage(downloadDir string, srcUrl string, timeout time.Duration) (string,
 			return "", err
 		}
 		klog.V(1).Info("Copy Completed!")
-	} else {
-		out, err := os.Create(dest)
-		if err != nil {
+		if err := verifyChecksum(dest, checksum); err != nil {
 			return "", err
 		}
-		defer out.Close()
-		klog.V(1).Infof("Downloading %s into %s", srcUrl, dest)
-		client := http.Client{
-			Timeout: timeout,
+	}
+	return dest, nil
+}
+
+// fetch downloads the srcUrl into the dest, the image is served from the local image cache if available
+// and the downloaded image is added to the cache after the checksum verification
+func fetch(httpClient *http.Client, srcUrl, dest, checksum string) error {
+	opt := pkg.ImageCMDOptions
+	var imageCache *cache.Cache
+	var key, etag string
+	if !opt.NoImageCache && opt.ImageCacheDir != "" {
+		var err error
+		if imageCache, err = cache.New(opt.ImageCacheDir); err != nil {
+			klog.Warningf("Image cache is not available, err: %v", err)
+		}
+	}
+	if imageCache != nil {
+		if checksum == "" {
+			etag = getETag(httpClient, srcUrl)
+		}
+		if checksum == "" && etag == "" {
+			klog.V(1).Infof("Neither the checksum nor the ETag is available for %s, skipping the image cache", srcUrl)
+			imageCache = nil
+		} else {
+			key = cache.Key(srcUrl, etag, checksum)
+			if entry, ok := imageCache.Get(key); ok {
+				klog.Infof("Using the cached image %s for %s", entry.File, srcUrl)
+				return imageCache.Fetch(key, dest)
+			}
+		}
+	}
+
+	klog.V(1).Infof("Downloading %s into %s", srcUrl, dest)
+	if err := download(httpClient, srcUrl, dest); err != nil {
+		return err
+	}
+	klog.V(1).Info("Download Completed!")
+	if err := verifyChecksum(dest, checksum); err != nil {
+		return err
+	}
+
+	if imageCache != nil {
+		if _, err := imageCache.Put(key, srcUrl, etag, checksum, dest); err != nil {
+			klog.Warningf("Failed to add the image to the cache, err: %v", err)
+			return nil
+		}
+		klog.V(1).Infof("Added the image %s to the cache %s", srcUrl, imageCache.Dir)
+		if removed, err := imageCache.Prune(opt.ImageCacheMaxSize*1024*1024*1024, 0); err != nil {
+			klog.Warningf("Failed to prune the image cache, err: %v", err)
+		} else {
+			for _, entry := range removed {
+				klog.V(1).Infof("Evicted the cached image for %s to keep the cache within %dG", entry.URL, opt.ImageCacheMaxSize)
+			}
 		}
-		resp, err := client.Get(srcUrl)
+	}
+	return nil
+}
+
+// getETag returns the ETag header of the srcUrl, empty if the server doesn't support it
+func getETag(httpClient *http.Client, srcUrl string) string {
+	req, err := newRequest(srcUrl)
+	if err != nil {
+		return ""
+	}
+	req.Method = http.MethodHead
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		klog.V(2).Infof("Failed to get the ETag for %s, err: %v", srcUrl, err)
+		return ""
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return ""
+	}
+	return resp.Header.Get("ETag")
+}
+
+// newHTTPClient returns the http client honouring the proxy settings
+func newHTTPClient(timeout time.Duration) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyFromEnvironment
+	if proxy := pkg.ImageCMDOptions.ImageProxy; proxy != "" {
+		proxyURL, err := url.Parse(proxy)
 		if err != nil {
-			return "", err
+			return nil, fmt.Errorf("invalid proxy url %s, err: %v", proxy, err)
 		}
-		defer resp.Body.Close()
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+	}, nil
+}
 
-		if resp.StatusCode != http.StatusOK {
-			return "", fmt.Errorf("failed to download the file: %s, status code: %d", srcUrl, resp.StatusCode)
+// newRequest creates a GET request with the authorization headers set
+func newRequest(srcUrl string) (*http.Request, error) {
+	req, err := http.NewRequest(http.MethodGet, srcUrl, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	opt := pkg.ImageCMDOptions
+	if opt.ImageURLToken != "" {
+		req.Header.Set("Authorization", "Bearer "+opt.ImageURLToken)
+	} else if opt.ImageURLUser != "" {
+		req.SetBasicAuth(opt.ImageURLUser, opt.ImageURLPassword)
+	}
+	return req, nil
+}
+
+// download fetches the srcUrl into dest, interrupted downloads are resumed with the HTTP range requests
+func download(httpClient *http.Client, srcUrl, dest string) error {
+	out, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	progress := mpb.New()
+	var bar *mpb.Bar
+	var written int64
+	var lastErr error
+	for attempt := 0; attempt <= downloadRetries; attempt++ {
+		if attempt > 0 {
+			klog.Warningf("Download of %s interrupted at %d bytes, err: %v, retrying(%d/%d)", srcUrl, written, lastErr, attempt, downloadRetries)
+			time.Sleep(retryInterval)
+		}
+		req, err := newRequest(srcUrl)
+		if err != nil {
+			return err
+		}
+		if written > 0 {
+			req.Header.Set("Range", fmt.Sprintf("bytes=%d-", written))
+		}
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		switch resp.StatusCode {
+		case http.StatusOK:
+			// Server doesn't support the range requests, start from the beginning
+			if written > 0 {
+				klog.V(1).Infof("Server doesn't support resuming the download, restarting")
+				if err := out.Truncate(0); err != nil {
+					resp.Body.Close()
+					return err
+				}
+				if _, err := out.Seek(0, io.SeekStart); err != nil {
+					resp.Body.Close()
+					return err
+				}
+				written = 0
+				if bar != nil {
+					bar.SetCurrent(0)
+				}
+			}
+		case http.StatusPartialContent:
+		default:
+			resp.Body.Close()
+			lastErr = fmt.Errorf("failed to download the file: %s, status code: %d", srcUrl, resp.StatusCode)
+			// Only the server side errors are worth a retry
+			if resp.StatusCode < http.StatusInternalServerError {
+				return lastErr
+			}
+			continue
+		}
+
+		if bar == nil {
+			var total int64
+			if resp.ContentLength > 0 {
+				total = written + resp.ContentLength
+			}
+			bar = progress.AddBar(total,
+				mpb.PrependDecorators(
+					decor.Name("Downloading: ", decor.WC{W: 15}),
+					decor.CountersKibiByte("% .2f / % .2f"),
+				),
+				mpb.AppendDecorators(
+					decor.Percentage(),
+				),
+			)
+			bar.SetCurrent(written)
 		}
 
-		_, err = io.Copy(out, resp.Body)
+		n, err := io.Copy(out, bar.ProxyReader(resp.Body))
+		resp.Body.Close()
+		written += n
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		bar.SetTotal(-1, true)
+		progress.Wait()
+		return nil
+	}
+	if bar != nil {
+		bar.Abort(false)
+	}
+	progress.Wait()
+	return fmt.Errorf("failed to download the file: %s after %d retries, err: %v", srcUrl, downloadRetries, lastErr)
+}
+
+// isCOSURL returns true for the s3://<bucket>/<object> and cos://<cos-instance-name>/<bucket>/<object> urls
+func isCOSURL(URL string) bool {
+	u, err := url.Parse(URL)
+	if err != nil {
+		return false
+	}
+	return u.Scheme == "s3" || u.Scheme == "cos"
+}
+
+// parseCOSURL returns the cos instance name(only for cos://), bucket and the object name from the URL
+func parseCOSURL(URL string) (instance, bucket, object string, err error) {
+	u, err := url.Parse(URL)
+	if err != nil {
+		return "", "", "", err
+	}
+	p := strings.TrimPrefix(u.Path, "/")
+	switch u.Scheme {
+	case "s3":
+		bucket, object = u.Host, p
+	case "cos":
+		instance = u.Host
+		if parts := strings.SplitN(p, "/", 2); len(parts) == 2 {
+			bucket, object = parts[0], parts[1]
+		}
+	default:
+		return "", "", "", fmt.Errorf("unsupported scheme %q in %s", u.Scheme, URL)
+	}
+	if bucket == "" || object == "" {
+		return "", "", "", fmt.Errorf("invalid url %s, expected format: s3://<bucket>/<object> or cos://<cos-instance-name>/<bucket>/<object>", URL)
+	}
+	return instance, bucket, object, nil
+}
+
+// downloadCOSObject downloads the object from the IBM Cloud Object Storage bucket
+func downloadCOSObject(srcUrl, dest string) error {
+	opt := pkg.ImageCMDOptions
+	instance, bucket, object, err := parseCOSURL(srcUrl)
+	if err != nil {
+		return err
+	}
+
+	var s3Cli *client.S3Client
+	if instance == "" {
+		if opt.AccessKey == "" || opt.SecretKey == "" {
+			return fmt.Errorf("--accesskey and --secretkey are required to download the image from %s", srcUrl)
+		}
+		s3Cli, err = client.NewS3ClientWithKeys(opt.AccessKey, opt.SecretKey, bucketRegion)
+		if err != nil {
+			return err
+		}
+	} else {
+		c, err := client.NewClientWithEnv(pkg.Options.APIKey, pkg.Options.Environment, pkg.Options.Debug)
+		if err != nil {
+			return err
+		}
+		s3Cli, err = client.NewS3Client(c, instance, bucketRegion)
+		if err != nil {
+			return err
+		}
+	}
+	return s3Cli.DownloadObject(bucket, object, dest)
+}
+
+// expectedChecksum returns the sha256 checksum of the image, the checksum is either taken from the
+// --image-checksum option or looked up from the checksum files published along with the image
+func expectedChecksum(srcUrl string, timeout time.Duration) (string, error) {
+	if checksum := pkg.ImageCMDOptions.ImageChecksum; checksum != "" {
+		algo, sum, found := strings.Cut(checksum, ":")
+		if !found || strings.ToLower(algo) != "sha256" {
+			return "", fmt.Errorf("invalid checksum %q, expected format: sha256:<checksum>", checksum)
+		}
+		return sum, nil
+	}
+	if isURL(srcUrl) && !isCOSURL(srcUrl) {
+		return lookupChecksum(srcUrl, timeout)
+	}
+	return "", nil
+}
+
+// verifyChecksum validates the sha256 checksum of the file, skipped when the expected checksum is not known
+func verifyChecksum(file, expected string) error {
+	if expected == "" {
+		klog.V(1).Infof("No checksum available for %s, skipping the verification", file)
+		return nil
+	}
+
+	klog.V(1).Infof("Verifying the sha256 checksum of %s", file)
+	actual, err := sha256sum(file)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(actual, expected) {
+		return fmt.Errorf("checksum mismatch for %s, expected: %s, got: %s", file, expected, actual)
+	}
+	klog.V(1).Info("Checksum verification passed")
+	return nil
+}
+
+// lookupChecksum fetches the checksum files next to the srcUrl and returns the checksum of the image if listed
+func lookupChecksum(srcUrl string, timeout time.Duration) (string, error) {
+	u, err := url.Parse(srcUrl)
+	if err != nil {
+		return "", err
+	}
+	fileName := path.Base(u.Path)
+	httpClient, err := newHTTPClient(timeout)
+	if err != nil {
+		return "", err
+	}
+	for _, checksumFile := range checksumFiles {
+		cu := *u
+		cu.Path = path.Join(path.Dir(u.Path), checksumFile)
+		cu.RawQuery = ""
+		req, err := newRequest(cu.String())
 		if err != nil {
 			return "", err
 		}
-		klog.V(1).Info("Download Completed!")
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			klog.V(2).Infof("Failed to fetch the checksum file %s, err: %v", cu.String(), err)
+			continue
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			klog.V(2).Infof("Checksum file %s not available, status code: %d", cu.String(), resp.StatusCode)
+			continue
+		}
+		sum := parseChecksum(resp.Body, fileName)
+		resp.Body.Close()
+		if sum != "" {
+			klog.V(1).Infof("Found the checksum for %s in %s", fileName, cu.String())
+			return sum, nil
+		}
 	}
-	return dest, nil
+	return "", nil
+}
+
+// parseChecksum finds the sha256 checksum of the file from the content in either of the formats:
+//   - GNU coreutils: <checksum>  <filename>
+//   - BSD: SHA256 (<filename>) = <checksum>
+func parseChecksum(r io.Reader, fileName string) string {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "SHA256 (") {
+			name, sum, found := strings.Cut(strings.TrimPrefix(line, "SHA256 ("), ") = ")
+			if found && name == fileName {
+				return strings.TrimSpace(sum)
+			}
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 2 && strings.TrimPrefix(fields[1], "*") == fileName && len(fields[0]) == sha256.Size*2 {
+			return fields[0]
+		}
+	}
+	return ""
+}
+
+// sha256sum returns the hex encoded sha256 checksum of the file
+func sha256sum(file string) (string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
 }
 
 // Copies the src to dest
@@ -17,6 +17,7 @@ package ova
 import (
 	"archive/tar"
 	"bytes"
+	"encoding/xml"
 	"fmt"
 	"io"
 	"os"
@@ -37,22 +38,34 @@ type OVA struct {
 	TargetDiskSize        int64
 	PvsadmVersion         string
 	OsId                  string
+	Provenance            Provenance
+}
+
+// Provenance records how the image was built, rendered into the pvsadmprovenancesection of the OVF
+type Provenance struct {
+	SourceURL        string `xml:"SourceURL" json:"sourceURL,omitempty"`
+	SourceChecksum   string `xml:"SourceChecksum" json:"sourceChecksum,omitempty"`
+	Distro           string `xml:"Distro" json:"distro,omitempty"`
+	PrepTemplateHash string `xml:"PrepTemplateHash" json:"prepTemplateHash,omitempty"`
+	CloudConfigHash  string `xml:"CloudConfigHash" json:"cloudConfigHash,omitempty"`
+	BuildHost        string `xml:"BuildHost" json:"buildHost,omitempty"`
+	BuildTimestamp   string `xml:"BuildTimestamp" json:"buildTimestamp,omitempty"`
 }
 
 // Render will generate the OVA spec from the template with all the required information like image name, volume name
 // and size
-func Render(imageName, volumeName string, srcVolumeSize int64, targetDiskSize int64, ImageDist string) (string, error) {
+func Render(imageName, volumeName string, srcVolumeSize int64, targetDiskSize int64, ImageDist string, provenance Provenance) (string, error) {
 	//Disk Size should be in bytes
 	osId := "79"
 	if ImageDist == "coreos" {
 		osId = "80"
 	}
 	o := OVA{
-		imageName, volumeName, srcVolumeSize, targetDiskSize * 1073741824, version.Get(), osId,
+		imageName, volumeName, srcVolumeSize, targetDiskSize * 1073741824, version.Get(), osId, provenance,
 	}
 
 	var wr bytes.Buffer
-	t := template.Must(template.New("ova").Parse(ovfTemplate))
+	t := template.Must(template.New("ova").Funcs(template.FuncMap{"xml": xmlEscape}).Parse(ovfTemplate))
 	err := t.Execute(&wr, o)
 	if err != nil {
 		return "", fmt.Errorf("error while rendoring the ova template: %v", err)
@@ -60,6 +73,15 @@ func Render(imageName, volumeName string, srcVolumeSize int64, targetDiskSize in
 	return wr.String(), nil
 }
 
+// xmlEscape escapes the string for the XML text
+func xmlEscape(s string) (string, error) {
+	var b bytes.Buffer
+	if err := xml.EscapeText(&b, []byte(s)); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
 // RenderMeta will generate the OVA meta spec from the template with the image name
 func RenderMeta(imageName string) (string, error) {
 	o := OVA{
@@ -75,7 +97,7 @@ func RenderMeta(imageName string) (string, error) {
 }
 
 // CreateTarArchive bundles the dir into a OVA image
-func CreateTarArchive(dir string, target string, targetDiskSize int64, imageDist string, volumeDiskName string) error {
+func CreateTarArchive(dir string, target string, targetDiskSize int64, imageDist string, volumeDiskName string, provenance Provenance) error {
 	ovf := filepath.Join(dir, volumeDiskName)
 	info, err := os.Stat(ovf)
 	if os.IsNotExist(err) {
@@ -86,7 +108,7 @@ func CreateTarArchive(dir string, target string, targetDiskSize int64, imageDist
 	if err != nil {
 		return fmt.Errorf("failed to render the meta specfile, got error '%s'", err.Error())
 	}
-	ovfSpec, err := Render(filepath.Base(target), volumeDiskName, volSize, targetDiskSize, imageDist)
+	ovfSpec, err := Render(filepath.Base(target), volumeDiskName, volSize, targetDiskSize, imageDist, provenance)
 	if err != nil {
 		return fmt.Errorf("failed to render the ovf specfile, got error '%s'", err.Error())
 	}
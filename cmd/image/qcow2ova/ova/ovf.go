@@ -0,0 +1,266 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ova
+
+import (
+	"archive/tar"
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	gzip "github.com/klauspost/pgzip"
+)
+
+// Envelope is the OVF descriptor of the OVA image
+type Envelope struct {
+	References []FileRef `xml:"References>File"`
+	Disks      []Disk    `xml:"DiskSection>Disk"`
+	Collection struct {
+		Name           string          `xml:"Name"`
+		VirtualSystems []VirtualSystem `xml:"VirtualSystem"`
+	} `xml:"VirtualSystemCollection"`
+	PvsadmVersion struct {
+		Info        string `xml:"Info"`
+		BuildNumber string `xml:"BuildNumber"`
+	} `xml:"pvsadmversionsection"`
+	Provenance Provenance `xml:"pvsadmprovenancesection"`
+}
+
+// FileRef is the file referenced by the OVF descriptor
+type FileRef struct {
+	Href string `xml:"href,attr"`
+	ID   string `xml:"id,attr"`
+	Size int64  `xml:"size,attr"`
+}
+
+// Disk is the virtual disk backed by the referenced file
+type Disk struct {
+	Capacity                string `xml:"capacity,attr"`
+	CapacityAllocationUnits string `xml:"capacityAllocationUnits,attr"`
+	DiskID                  string `xml:"diskId,attr"`
+	FileRef                 string `xml:"fileRef,attr"`
+}
+
+// VirtualSystem is the virtual machine described by the OVF descriptor
+type VirtualSystem struct {
+	ID              string `xml:"id,attr"`
+	Name            string `xml:"Name"`
+	OperatingSystem struct {
+		ID           string `xml:"id,attr"`
+		Description  string `xml:"Description"`
+		Architecture string `xml:"architecture"`
+	} `xml:"OperatingSystemSection"`
+	Items []struct {
+		ElementName  string `xml:"ElementName"`
+		HostResource string `xml:"HostResource"`
+		ResourceType string `xml:"ResourceType"`
+		Boot         string `xml:"boot"`
+	} `xml:"VirtualHardwareSection>Item"`
+}
+
+// ParseOVF parses the OVF descriptor
+func ParseOVF(data []byte) (*Envelope, error) {
+	e := &Envelope{}
+	if err := xml.Unmarshal(data, e); err != nil {
+		return nil, fmt.Errorf("failed to parse the ovf, err: %v", err)
+	}
+	return e, nil
+}
+
+// File returns the referenced file with the id
+func (e *Envelope) File(id string) (FileRef, bool) {
+	for _, f := range e.References {
+		if f.ID == id {
+			return f, true
+		}
+	}
+	return FileRef{}, false
+}
+
+// DiskFile returns the file backing the first disk of the envelope
+func (e *Envelope) DiskFile() (FileRef, bool) {
+	if len(e.Disks) == 0 {
+		return FileRef{}, false
+	}
+	return e.File(e.Disks[0].FileRef)
+}
+
+var unitsRegex = regexp.MustCompile(`^byte(\s*\*\s*2\^(\d+))?$`)
+
+// CapacityBytes returns the disk capacity in bytes, the capacityAllocationUnits is either byte or byte * 2^n
+func (d Disk) CapacityBytes() (int64, error) {
+	capacity, err := strconv.ParseInt(d.Capacity, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid capacity %q for the disk %s", d.Capacity, d.DiskID)
+	}
+	units := strings.TrimSpace(d.CapacityAllocationUnits)
+	if units == "" {
+		return capacity, nil
+	}
+	m := unitsRegex.FindStringSubmatch(units)
+	if m == nil {
+		return 0, fmt.Errorf("unsupported capacityAllocationUnits %q for the disk %s", units, d.DiskID)
+	}
+	if m[2] != "" {
+		exp, _ := strconv.Atoi(m[2])
+		if exp > 62 || capacity > math.MaxInt64>>exp {
+			return 0, fmt.Errorf("capacity of the disk %s is too large", d.DiskID)
+		}
+		capacity <<= exp
+	}
+	return capacity, nil
+}
+
+// Archive is the content listing of the OVA image
+type Archive struct {
+	// OVFName is the name of the OVF descriptor in the archive
+	OVFName string
+	// OVF is the raw OVF descriptor
+	OVF []byte
+	// Meta is the content of the meta file, empty if not present
+	Meta string
+	// Files are the sizes of the files in the archive keyed by the name
+	Files map[string]int64
+	// Names are the file names in the archive order
+	Names []string
+	// Compressed is true for the gzip compressed archive
+	Compressed bool
+	// CompressedSize is the size of the archive file
+	CompressedSize int64
+	// Size is the uncompressed size of the archive
+	Size int64
+}
+
+// countingReader counts the bytes read from the reader
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// openArchive returns the reader for the OVA image, the gzip compressed image is decompressed on the fly
+func openArchive(file string) (io.Reader, bool, io.Closer, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, false, nil, err
+	}
+	br := bufio.NewReader(f)
+	magic, err := br.Peek(2)
+	if err != nil {
+		f.Close()
+		return nil, false, nil, fmt.Errorf("failed to read the %s, err: %v", file, err)
+	}
+	if magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			f.Close()
+			return nil, false, nil, err
+		}
+		return gz, true, f, nil
+	}
+	return br, false, f, nil
+}
+
+// ReadArchive lists the files in the OVA image and reads the OVF descriptor and the meta file
+func ReadArchive(file string) (*Archive, error) {
+	r, compressed, closer, err := openArchive(file)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	info, err := os.Stat(file)
+	if err != nil {
+		return nil, err
+	}
+	cr := &countingReader{r: r}
+	tr := tar.NewReader(cr)
+
+	a := &Archive{Files: map[string]int64{}, Compressed: compressed, CompressedSize: info.Size()}
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to read the archive %s, err: %v", file, err)
+		}
+		a.Files[hdr.Name] = hdr.Size
+		a.Names = append(a.Names, hdr.Name)
+		switch path.Ext(hdr.Name) {
+		case ".ovf":
+			if a.OVFName != "" {
+				return nil, fmt.Errorf("multiple ovf files found in the archive: %s, %s", a.OVFName, hdr.Name)
+			}
+			a.OVFName = hdr.Name
+			if a.OVF, err = io.ReadAll(tr); err != nil {
+				return nil, err
+			}
+		case ".meta":
+			content, err := io.ReadAll(tr)
+			if err != nil {
+				return nil, err
+			}
+			a.Meta = string(content)
+		}
+	}
+	// Count the trailing padding of the archive
+	if _, err := io.Copy(io.Discard, cr); err != nil {
+		return nil, err
+	}
+	a.Size = cr.n
+	if a.OVFName == "" {
+		return nil, fmt.Errorf(".ovf file is not found in %s image", file)
+	}
+	return a, nil
+}
+
+// ExtractFile extracts the file with the name from the OVA image to the dest
+func ExtractFile(file, name, dest string) error {
+	r, _, closer, err := openArchive(file)
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return fmt.Errorf("%s is not found in %s image", name, file)
+		} else if err != nil {
+			return err
+		}
+		if hdr.Name != name {
+			continue
+		}
+		out, err := os.Create(dest)
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+		_, err = io.Copy(out, tr)
+		return err
+	}
+}
@@ -60,4 +60,14 @@ var ovfTemplate = `<?xml version="1.0" encoding="UTF-8"?>
     <ovf:Info>Specifies pvsadm version </ovf:Info>
     <ovf:BuildNumber>{{.PvsadmVersion}}</ovf:BuildNumber>
   </ovf:pvsadmversionsection>
+  <ovf:pvsadmprovenancesection ovf:required="false">
+    <ovf:Info>Specifies the build provenance of the image</ovf:Info>
+    <ovf:SourceURL>{{xml .Provenance.SourceURL}}</ovf:SourceURL>
+    <ovf:SourceChecksum>{{xml .Provenance.SourceChecksum}}</ovf:SourceChecksum>
+    <ovf:Distro>{{xml .Provenance.Distro}}</ovf:Distro>
+    <ovf:PrepTemplateHash>{{xml .Provenance.PrepTemplateHash}}</ovf:PrepTemplateHash>
+    <ovf:CloudConfigHash>{{xml .Provenance.CloudConfigHash}}</ovf:CloudConfigHash>
+    <ovf:BuildHost>{{xml .Provenance.BuildHost}}</ovf:BuildHost>
+    <ovf:BuildTimestamp>{{xml .Provenance.BuildTimestamp}}</ovf:BuildTimestamp>
+  </ovf:pvsadmprovenancesection>
 </ovf:Envelope>`
@@ -0,0 +1,168 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prep
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v2"
+)
+
+// CloudConfigVars are the variables used for rendering the CloudConfig template
+type CloudConfigVars struct {
+	// DefaultUser is the name of the default user created by the cloud-init
+	DefaultUser string
+	// Timezone of the instance
+	Timezone string
+	// DatasourceList is the list of the datasources searched by the cloud-init
+	DatasourceList []string
+	// SSHPwauth enables the ssh password authentication
+	SSHPwauth bool
+	// RunCmd are the extra commands appended to the runcmd
+	RunCmd []string
+}
+
+// CloudConfigKeys documents the variables which can be set with the --cloud-config-set option
+var CloudConfigKeys = map[string]string{
+	"default_user":    "name of the default user(default: cloud-user)",
+	"timezone":        "timezone of the instance(default: UTC)",
+	"datasource_list": "comma separated list of the cloud-init datasources(default: ConfigDrive,NoCloud,None)",
+	"ssh_pwauth":      "enable the ssh password authentication(default: false)",
+	"runcmd":          "extra command to run on the first boot, can be set multiple times",
+}
+
+// CloudConfigValues are used for rendering the CloudConfig, overridden with the --cloud-config-set option
+var CloudConfigValues = DefaultCloudConfigVars()
+
+// UserCloudConfig is the cloud config set with the --cloud-config option, written as is instead of the rendered
+// CloudConfig template
+var UserCloudConfig string
+
+// jinjaHeader marks the cloud-init configs rendered by the cloud-init itself, which aren't the valid YAML until then
+const jinjaHeader = "## template: jinja"
+
+var (
+	timezoneRegex   = regexp.MustCompile(`^[A-Za-z0-9_+/-]+$`)
+	datasourceRegex = regexp.MustCompile(`^[A-Za-z0-9]+$`)
+)
+
+// DefaultCloudConfigVars returns the variables of the default cloud config
+func DefaultCloudConfigVars() *CloudConfigVars {
+	return &CloudConfigVars{
+		DefaultUser:    "cloud-user",
+		Timezone:       "UTC",
+		DatasourceList: []string{"ConfigDrive", "NoCloud", "None"},
+	}
+}
+
+// Set sets the variable from the key=value string
+func (v *CloudConfigVars) Set(kv string) error {
+	key, value, found := strings.Cut(kv, "=")
+	if !found {
+		return fmt.Errorf("invalid cloud config variable %q, expected format: key=value", kv)
+	}
+	key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+	switch key {
+	case "default_user":
+		if !nameRegex.MatchString(value) {
+			return fmt.Errorf("invalid default_user %q", value)
+		}
+		v.DefaultUser = value
+	case "timezone":
+		if !timezoneRegex.MatchString(value) {
+			return fmt.Errorf("invalid timezone %q", value)
+		}
+		v.Timezone = value
+	case "datasource_list":
+		var list []string
+		for _, ds := range strings.Split(value, ",") {
+			ds = strings.TrimSpace(ds)
+			if !datasourceRegex.MatchString(ds) {
+				return fmt.Errorf("invalid datasource %q", ds)
+			}
+			list = append(list, ds)
+		}
+		v.DatasourceList = list
+	case "ssh_pwauth":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid ssh_pwauth %q, expected true or false", value)
+		}
+		v.SSHPwauth = b
+	case "runcmd":
+		if value == "" {
+			return fmt.Errorf("runcmd can't be empty")
+		}
+		v.RunCmd = append(v.RunCmd, value)
+	default:
+		var keys []string
+		for k := range CloudConfigKeys {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		return fmt.Errorf("unknown cloud config variable %q, supported: %s", key, strings.Join(keys, ", "))
+	}
+	return nil
+}
+
+// RenderCloudConfig renders the CloudConfig template with the CloudConfigValues and validates the result is a valid YAML,
+// the UserCloudConfig is returned as is when set and validated unless it is a cloud-init jinja template
+func RenderCloudConfig() (string, error) {
+	if UserCloudConfig != "" {
+		if strings.HasPrefix(strings.TrimSpace(UserCloudConfig), jinjaHeader) {
+			return UserCloudConfig, nil
+		}
+		var out map[string]interface{}
+		if err := yaml.Unmarshal([]byte(UserCloudConfig), &out); err != nil {
+			return "", fmt.Errorf("cloud config is not a valid YAML: %v", err)
+		}
+		return UserCloudConfig, nil
+	}
+	funcs := template.FuncMap{
+		"join":   strings.Join,
+		"toJSON": toJSON,
+	}
+	t, err := template.New("cloud-config").Funcs(funcs).Option("missingkey=error").Parse(CloudConfig)
+	if err != nil {
+		return "", fmt.Errorf("error while parsing the cloud config template: %v", err)
+	}
+	var wr bytes.Buffer
+	if err := t.Execute(&wr, CloudConfigValues); err != nil {
+		return "", fmt.Errorf("error while rendering the cloud config template: %v", err)
+	}
+	var out map[string]interface{}
+	if err := yaml.Unmarshal(wr.Bytes(), &out); err != nil {
+		return "", fmt.Errorf("rendered cloud config is not a valid YAML: %v", err)
+	}
+	return wr.String(), nil
+}
+
+// toJSON quotes the string as the JSON string, which is a valid YAML double quoted scalar
+func toJSON(s string) (string, error) {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(s); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(b.String(), "\n"), nil
+}
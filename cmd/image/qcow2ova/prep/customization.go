@@ -0,0 +1,304 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prep
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ImageCustomization is applied on top of the image preparation template, set with the --customization option
+var ImageCustomization *Customization
+
+// Customization is the declarative image customization applied during the image preparation
+type Customization struct {
+	Repos      []Repo   `yaml:"repos"`
+	Packages   []string `yaml:"packages"`
+	Files      []File   `yaml:"files"`
+	Services   Services `yaml:"services"`
+	KernelArgs []string `yaml:"kernelArgs"`
+	Users      []User   `yaml:"users"`
+	Scripts    []string `yaml:"scripts"`
+}
+
+// Repo is the yum repository added to the image
+type Repo struct {
+	Name     string `yaml:"name"`
+	BaseURL  string `yaml:"baseurl"`
+	GPGKey   string `yaml:"gpgkey"`
+	GPGCheck bool   `yaml:"gpgcheck"`
+}
+
+// File is injected into the image, the content is either inline or read from the source file on the host
+type File struct {
+	Path        string `yaml:"path"`
+	Content     string `yaml:"content"`
+	Source      string `yaml:"source"`
+	Permissions string `yaml:"permissions"`
+	Owner       string `yaml:"owner"`
+}
+
+// Services are the systemd units enabled or disabled in the image
+type Services struct {
+	Enable  []string `yaml:"enable"`
+	Disable []string `yaml:"disable"`
+}
+
+// User is created in the image
+type User struct {
+	Name              string   `yaml:"name"`
+	Groups            []string `yaml:"groups"`
+	Sudo              bool     `yaml:"sudo"`
+	SSHAuthorizedKeys []string `yaml:"sshAuthorizedKeys"`
+}
+
+var (
+	nameRegex = regexp.MustCompile(`^[a-z_][a-z0-9_-]*$`)
+	unitRegex = regexp.MustCompile(`^[A-Za-z0-9@_.:-]+$`)
+	repoRegex = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+)
+
+var customizationTemplate = `
+# Customizations from the --customization file
+{{- if .Packages }}
+yum install -y{{ range .Packages }} {{ quote . }}{{ end }}
+{{- end }}
+{{- range .Users }}
+id -u {{ .Name }} &>/dev/null || useradd -m {{ .Name }}
+{{- if .Groups }}
+usermod -aG {{ join .Groups "," }} {{ .Name }}
+{{- end }}
+{{- if .Sudo }}
+echo '{{ .Name }} ALL=(ALL) NOPASSWD:ALL' > /etc/sudoers.d/{{ .Name }}
+chmod 0440 /etc/sudoers.d/{{ .Name }}
+{{- end }}
+{{- if .SSHAuthorizedKeys }}
+home=$(getent passwd {{ .Name }} | cut -d: -f6)
+install -d -m 0700 -o {{ .Name }} -g $(id -gn {{ .Name }}) ${home}/.ssh
+{{- range .SSHAuthorizedKeys }}
+echo {{ quote . }} >> ${home}/.ssh/authorized_keys
+{{- end }}
+chmod 0600 ${home}/.ssh/authorized_keys
+chown {{ .Name }}: ${home}/.ssh/authorized_keys
+{{- end }}
+{{- end }}
+{{- range .Files }}
+{{- if .Owner }}
+chown {{ quote .Owner }} {{ quote .Path }}
+{{- end }}
+{{- end }}
+{{- range .Services.Enable }}
+systemctl enable {{ . }}
+{{- end }}
+{{- range .Services.Disable }}
+systemctl disable {{ . }}
+{{- end }}
+{{- if .KernelArgs }}
+sed -i 's/^GRUB_CMDLINE_LINUX="\(.*\)"$/GRUB_CMDLINE_LINUX="\1 {{ sedEscape (join .KernelArgs " ") }}"/g' /etc/default/grub
+{{- end }}
+{{- range $i, $s := .Scripts }}
+# script {{ $i }}
+(
+{{ $s }}
+)
+{{- end }}
+`
+
+// LoadCustomization reads and validates the customization file, the relative file sources are resolved from the
+// directory of the customization file
+func LoadCustomization(file string) (*Customization, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	c := &Customization{}
+	if err := yaml.UnmarshalStrict(content, c); err != nil {
+		return nil, fmt.Errorf("failed to parse the customization file %s, err: %v", file, err)
+	}
+	for i := range c.Files {
+		f := &c.Files[i]
+		if f.Source == "" {
+			continue
+		}
+		if f.Content != "" {
+			return nil, fmt.Errorf("only one of content or source can be set for the file %s", f.Path)
+		}
+		src := f.Source
+		if !filepath.IsAbs(src) {
+			src = filepath.Join(filepath.Dir(file), src)
+		}
+		data, err := os.ReadFile(src)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the source of the file %s, err: %v", f.Path, err)
+		}
+		f.Content = string(data)
+	}
+	if err := c.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid customization file %s, err: %v", file, err)
+	}
+	return c, nil
+}
+
+// Validate checks the customization for the values which can't be applied to the image
+func (c *Customization) Validate() error {
+	for _, r := range c.Repos {
+		if !repoRegex.MatchString(r.Name) {
+			return fmt.Errorf("invalid repo name %q", r.Name)
+		}
+		if r.BaseURL == "" {
+			return fmt.Errorf("baseurl is required for the repo %s", r.Name)
+		}
+	}
+	for _, p := range c.Packages {
+		if strings.TrimSpace(p) == "" {
+			return fmt.Errorf("package name can't be empty")
+		}
+	}
+	for _, f := range c.Files {
+		if !path.IsAbs(f.Path) {
+			return fmt.Errorf("file path %q must be absolute", f.Path)
+		}
+		if path.Clean(f.Path) == "/" || slices.Contains(strings.Split(f.Path, "/"), "..") {
+			return fmt.Errorf("file path %q must not contain .. and must name a file", f.Path)
+		}
+		if _, err := f.mode(); err != nil {
+			return err
+		}
+	}
+	for _, s := range append(c.Services.Enable, c.Services.Disable...) {
+		if !unitRegex.MatchString(s) {
+			return fmt.Errorf("invalid systemd unit %q", s)
+		}
+	}
+	for _, u := range c.Users {
+		if !nameRegex.MatchString(u.Name) {
+			return fmt.Errorf("invalid user name %q", u.Name)
+		}
+		for _, g := range u.Groups {
+			if !nameRegex.MatchString(g) {
+				return fmt.Errorf("invalid group name %q for the user %s", g, u.Name)
+			}
+		}
+	}
+	for _, k := range c.KernelArgs {
+		if k == "" || strings.ContainsAny(k, "\"\n") {
+			return fmt.Errorf("invalid kernel argument %q", k)
+		}
+	}
+	return nil
+}
+
+// Render returns the bash snippet applying the customization, the files are not part of the snippet and
+// are written into the image with WriteFiles
+func (c *Customization) Render() (string, error) {
+	funcs := template.FuncMap{
+		"quote":     shellQuote,
+		"join":      strings.Join,
+		"sedEscape": sedEscape,
+	}
+	var wr bytes.Buffer
+	t := template.Must(template.New("customization").Funcs(funcs).Parse(customizationTemplate))
+	if err := t.Execute(&wr, c); err != nil {
+		return "", fmt.Errorf("error while rendering the customization template: %v", err)
+	}
+	return wr.String(), nil
+}
+
+// WriteFiles writes the repos and the files into the image mounted at mnt. The paths are resolved within the mnt, the
+// symlinks of the image pointing outside of it, e.g: the absolute ones, fail the write instead of being followed on
+// the host and the symlinks at the path are replaced by the file.
+func (c *Customization) WriteFiles(mnt string) error {
+	root, err := os.OpenRoot(mnt)
+	if err != nil {
+		return err
+	}
+	defer root.Close()
+	for _, r := range c.Repos {
+		if err := writeFile(root, path.Join("etc", "yum.repos.d", r.Name+".repo"), []byte(r.content()), 0644); err != nil {
+			return fmt.Errorf("failed to add the repo %s, err: %v", r.Name, err)
+		}
+	}
+	for _, f := range c.Files {
+		mode, err := f.mode()
+		if err != nil {
+			return err
+		}
+		dest := strings.TrimPrefix(path.Clean(f.Path), "/")
+		if err := root.MkdirAll(path.Dir(dest), 0755); err != nil {
+			return fmt.Errorf("failed to create the directory of the file %s, err: %v", f.Path, err)
+		}
+		if err := writeFile(root, dest, []byte(f.Content), mode); err != nil {
+			return fmt.Errorf("failed to write the file %s, err: %v", f.Path, err)
+		}
+	}
+	return nil
+}
+
+// writeFile writes the file within the root, replacing the symlink at the name
+func writeFile(root *os.Root, name string, data []byte, mode os.FileMode) error {
+	if fi, err := root.Lstat(name); err == nil && fi.Mode()&os.ModeSymlink != 0 {
+		if err := root.Remove(name); err != nil {
+			return err
+		}
+	}
+	if err := root.WriteFile(name, data, mode); err != nil {
+		return err
+	}
+	// WriteFile doesn't change the mode of the existing files and the mode is subjected to the umask
+	return root.Chmod(name, mode)
+}
+
+func (r Repo) content() string {
+	gpgcheck := 0
+	if r.GPGCheck {
+		gpgcheck = 1
+	}
+	s := fmt.Sprintf("[%s]\nname=%s\nbaseurl=%s\nenabled=1\ngpgcheck=%d\n", r.Name, r.Name, r.BaseURL, gpgcheck)
+	if r.GPGKey != "" {
+		s += fmt.Sprintf("gpgkey=%s\n", r.GPGKey)
+	}
+	return s
+}
+
+func (f File) mode() (os.FileMode, error) {
+	if f.Permissions == "" {
+		return 0644, nil
+	}
+	m, err := strconv.ParseUint(f.Permissions, 8, 32)
+	if err != nil || m > 0777 {
+		return 0, fmt.Errorf("invalid permissions %q for the file %s, expected octal e.g: 0644", f.Permissions, f.Path)
+	}
+	return os.FileMode(m), nil
+}
+
+// shellQuote quotes the string for the bash
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// sedEscape escapes the string for the replacement part of the sed substitute command
+func sedEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `/`, `\/`, `&`, `\&`, `'`, `'\''`).Replace(s)
+}
@@ -0,0 +1,194 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prep
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const sampleCustomization = `
+repos:
+- name: epel
+  baseurl: https://dl.fedoraproject.org/pub/epel/9/Everything/ppc64le/
+  gpgcheck: true
+  gpgkey: https://dl.fedoraproject.org/pub/epel/RPM-GPG-KEY-EPEL-9
+packages:
+- vim
+- tmux
+files:
+- path: /etc/motd
+  content: "Welcome"
+- path: /etc/myapp/config
+  source: config
+  permissions: "0600"
+  owner: admin
+services:
+  enable: [chronyd]
+  disable: [kdump.service]
+kernelArgs: [nosmt, "console=hvc0"]
+users:
+- name: admin
+  groups: [wheel]
+  sudo: true
+  sshAuthorizedKeys: ["ssh-ed25519 AAAA admin@example.com"]
+scripts:
+- echo 'custom step'
+`
+
+func TestLoadCustomization(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:    "valid customization",
+			content: sampleCustomization,
+			want: []string{
+				"yum install -y 'vim' 'tmux'",
+				"useradd -m admin",
+				"usermod -aG wheel admin",
+				"/etc/sudoers.d/admin",
+				"echo 'ssh-ed25519 AAAA admin@example.com' >>",
+				"chown 'admin' '/etc/myapp/config'",
+				"systemctl enable chronyd",
+				"systemctl disable kdump.service",
+				`GRUB_CMDLINE_LINUX="\1 nosmt console=hvc0"`,
+				"echo 'custom step'",
+			},
+		},
+		{
+			name:    "unknown field",
+			content: "package: [vim]",
+			wantErr: true,
+		},
+		{
+			name:    "relative file path",
+			content: "files: [{path: etc/motd, content: hello}]",
+			wantErr: true,
+		},
+		{
+			name:    "file path escaping the image",
+			content: "files: [{path: /../../etc/shadow, content: hello}]",
+			wantErr: true,
+		},
+		{
+			name:    "invalid permissions",
+			content: "files: [{path: /etc/motd, content: hello, permissions: rw}]",
+			wantErr: true,
+		},
+		{
+			name:    "both content and source",
+			content: "files: [{path: /etc/motd, content: hello, source: config}]",
+			wantErr: true,
+		},
+		{
+			name:    "invalid user name",
+			content: "users: [{name: 'root; rm -rf /'}]",
+			wantErr: true,
+		},
+		{
+			name:    "invalid service",
+			content: "services: {enable: ['sshd && reboot']}",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(dir, "config"), []byte("key=value"), 0644); err != nil {
+				t.Fatal(err)
+			}
+			file := filepath.Join(dir, "customization.yaml")
+			if err := os.WriteFile(file, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+			c, err := LoadCustomization(file)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadCustomization() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			got, err := c.Render()
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			for _, w := range tt.want {
+				if !strings.Contains(got, w) {
+					t.Errorf("Render() %s does not contain the %s", got, w)
+				}
+			}
+
+			mnt := t.TempDir()
+			if err := os.MkdirAll(filepath.Join(mnt, "etc", "yum.repos.d"), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := c.WriteFiles(mnt); err != nil {
+				t.Fatalf("WriteFiles() error = %v", err)
+			}
+			content, err := os.ReadFile(filepath.Join(mnt, "etc", "myapp", "config"))
+			if err != nil || string(content) != "key=value" {
+				t.Errorf("WriteFiles() config = %s, err: %v", content, err)
+			}
+			info, err := os.Stat(filepath.Join(mnt, "etc", "myapp", "config"))
+			if err != nil || info.Mode().Perm() != 0600 {
+				t.Errorf("WriteFiles() config mode = %v, err: %v", info.Mode(), err)
+			}
+			repo, err := os.ReadFile(filepath.Join(mnt, "etc", "yum.repos.d", "epel.repo"))
+			if err != nil || !strings.Contains(string(repo), "gpgcheck=1") {
+				t.Errorf("WriteFiles() epel.repo = %s, err: %v", repo, err)
+			}
+		})
+	}
+}
+
+func TestCustomizationWriteFilesSymlinks(t *testing.T) {
+	host := t.TempDir()
+	mnt := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(mnt, "etc"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	// The absolute symlinks of the image point to the host when followed outside of the chroot
+	if err := os.WriteFile(filepath.Join(host, "zoneinfo"), []byte("host"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join(host, "zoneinfo"), filepath.Join(mnt, "etc", "localtime")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(host, filepath.Join(mnt, "opt")); err != nil {
+		t.Fatal(err)
+	}
+
+	c := &Customization{Files: []File{{Path: "/etc/localtime", Content: "image"}}}
+	if err := c.WriteFiles(mnt); err != nil {
+		t.Fatalf("WriteFiles() error = %v", err)
+	}
+	if content, err := os.ReadFile(filepath.Join(mnt, "etc", "localtime")); err != nil || string(content) != "image" {
+		t.Errorf("WriteFiles() localtime = %s, err: %v, want the symlink replaced", content, err)
+	}
+
+	c = &Customization{Files: []File{{Path: "/opt/zoneinfo", Content: "image"}}}
+	if err := c.WriteFiles(mnt); err == nil {
+		t.Errorf("WriteFiles() through the symlink to the host returned no error")
+	}
+	if content, err := os.ReadFile(filepath.Join(host, "zoneinfo")); err != nil || string(content) != "host" {
+		t.Errorf("host file = %s, err: %v, want it untouched", content, err)
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prep
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// PReP boot partition types for the dos and gpt partition tables
+const (
+	prepDosType = "41"
+	prepGPTType = "9E1A2D38-C612-4316-AA26-8B49521E5A8B"
+)
+
+// PartitionTable is the partition table of the raw disk as reported by the sfdisk
+type PartitionTable struct {
+	Label      string      `json:"label"`
+	Partitions []Partition `json:"partitions"`
+}
+
+// Partition is the partition of the raw disk
+type Partition struct {
+	Node  string `json:"node"`
+	Start int64  `json:"start"`
+	Size  int64  `json:"size"`
+	Type  string `json:"type"`
+}
+
+// HasPReP returns true if the partition table has the PReP boot partition required for booting on Power
+func (p *PartitionTable) HasPReP() bool {
+	for _, part := range p.Partitions {
+		if strings.EqualFold(part.Type, prepDosType) || strings.EqualFold(part.Type, prepGPTType) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParsePartitionTable parses the sfdisk --json output
+func ParsePartitionTable(data []byte) (*PartitionTable, error) {
+	var out struct {
+		PartitionTable *PartitionTable `json:"partitiontable"`
+	}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, fmt.Errorf("failed to parse the partition table, err: %v", err)
+	}
+	if out.PartitionTable == nil {
+		return nil, fmt.Errorf("partition table not found")
+	}
+	return out.PartitionTable, nil
+}
+
+// GetPartitionTable returns the partition table of the raw disk file
+func GetPartitionTable(file string) (*PartitionTable, error) {
+	out, err := exec.Command("sfdisk", "--json", file).Output()
+	if err != nil {
+		var stderr string
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			stderr = string(exitErr.Stderr)
+		}
+		return nil, fmt.Errorf("failed to read the partition table of %s, err: %v, stderr: %s", file, err, stderr)
+	}
+	return ParsePartitionTable(out)
+}
+
+// MountImage mounts the root and the boot partitions of the raw disk read-only at the mnt, returns the filesystem
+// type of the root partition and the function to unmount the image
+func MountImage(volume, mnt string) (string, func(), error) {
+	lo, err := setupLoop(volume)
+	if err != nil {
+		return "", nil, err
+	}
+	var cleanups []func()
+	cleanup := func() {
+		for i := len(cleanups) - 1; i >= 0; i-- {
+			cleanups[i]()
+		}
+		removeLoop(lo)
+	}
+
+	if err := partprobe(lo); err != nil {
+		cleanup()
+		return "", nil, err
+	}
+	partition, err := getPartition(lo)
+	if err != nil {
+		cleanup()
+		return "", nil, err
+	}
+	partDev := lo + "p" + partition
+	fsType, err := getFSType(partDev)
+	if err != nil {
+		cleanup()
+		return "", nil, err
+	}
+	if err := mount(mountOpts(fsType), partDev, mnt); err != nil {
+		cleanup()
+		return "", nil, err
+	}
+	cleanups = append(cleanups, func() { Umount(mnt) })
+
+	deviceuuid, err := bootDeviceuuid(filepath.Join(mnt, "etc", "fstab"))
+	if err != nil {
+		cleanup()
+		return "", nil, err
+	}
+	if deviceuuid != "" {
+		bootDev, err := findDevice(deviceuuid)
+		if err != nil {
+			cleanup()
+			return "", nil, err
+		}
+		bootFSType, err := getFSType(bootDev)
+		if err != nil {
+			cleanup()
+			return "", nil, err
+		}
+		if err := mount(mountOpts(bootFSType), bootDev, filepath.Join(mnt, "boot")); err != nil {
+			cleanup()
+			return "", nil, err
+		}
+		cleanups = append(cleanups, func() { Umount(filepath.Join(mnt, "boot")) })
+	}
+	return fsType, cleanup, nil
+}
+
+// mountOpts returns the read-only mount options for the filesystem, the xfs is mounted without the log recovery
+// to leave the image untouched
+func mountOpts(fsType string) string {
+	if fsType == "xfs" {
+		return "ro,nouuid,norecovery"
+	}
+	return "ro"
+}
@@ -34,7 +34,7 @@ var (
 // - Install and configure multipath for rootfs
 // - Install all the required modules for PowerVM
 // - Sets the root password
-func prepare(mnt, volume, dist, rhnuser, rhnpasswd, rootpasswd string) error {
+func prepare(mnt, volume string, setup Setup) error {
 	lo, err := setupLoop(volume)
 	if err != nil {
 		return err
@@ -125,16 +125,20 @@ func prepare(mnt, volume, dist, rhnuser, rhnpasswd, rootpasswd string) error {
 	}
 	defer UmountHostPartitions(mnt)
 
-	setupStr, err := Render(dist, rhnuser, rhnpasswd, rootpasswd)
+	setupStr, err := Render(setup)
 	if err != nil {
 		return err
 	}
-	err = os.WriteFile(filepath.Join(mnt, "setup.sh"), []byte(setupStr), 0744)
+	err = os.WriteFile(filepath.Join(mnt, "setup.sh"), []byte(setupStr), 0700)
 	if err != nil {
 		return err
 	}
 
-	err = os.WriteFile(filepath.Join(mnt, "/etc/cloud/cloud.cfg"), []byte(CloudConfig), 0644)
+	cloudConfig, err := RenderCloudConfig()
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(filepath.Join(mnt, "/etc/cloud/cloud.cfg"), []byte(cloudConfig), 0644)
 	if err != nil {
 		return err
 	}
@@ -144,6 +148,13 @@ func prepare(mnt, volume, dist, rhnuser, rhnpasswd, rootpasswd string) error {
 		return err
 	}
 
+	if ImageCustomization != nil {
+		err = ImageCustomization.WriteFiles(mnt)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = Chroot(mnt)
 	if err != nil {
 		return err
@@ -155,7 +166,10 @@ func prepare(mnt, volume, dist, rhnuser, rhnpasswd, rootpasswd string) error {
 		return err
 	}
 
-	status, out, errr := utils.RunCMD("/setup.sh")
+	// Remove the setup script from the image, it may contain the secrets if rendered from the custom template
+	defer os.Remove("/setup.sh")
+
+	status, out, errr := utils.RunCMDWithEnv(setup.Env(), "/setup.sh")
 	if status != 0 {
 		return fmt.Errorf("script /setup.sh failed with exitstatus: %d, stdout: %s, stderr: %s", status, out, errr)
 	}
@@ -169,15 +183,16 @@ func UmountHostPartitions(mnt string) {
 	}
 }
 
-func Prepare4capture(mnt, volume, dist, rhnuser, rhnpasswd, rootpasswd string) error {
+func Prepare4capture(mnt, volume string, setup Setup) error {
 	//cwd, err := os.Getwd()
 	//if err != nil {
 	//	return err
 	//}
 	//defer os.Chdir(cwd)
-	switch dist := strings.ToLower(dist); dist {
+	setup.Dist = strings.ToLower(setup.Dist)
+	switch dist := setup.Dist; dist {
 	case "rhel", "centos":
-		return prepare(mnt, volume, dist, rhnuser, rhnpasswd, rootpasswd)
+		return prepare(mnt, volume, setup)
 	case "coreos":
 		klog.Info("No image preparation required for the coreos.")
 		return nil
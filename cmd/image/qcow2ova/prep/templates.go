@@ -29,10 +29,15 @@ set -o pipefail
 mv /etc/resolv.conf /etc/resolv.conf.orig || true
 echo "nameserver 9.9.9.9" | tee /etc/resolv.conf
 {{if eq .Dist "rhel"}}
-subscription-manager register --force --auto-attach --username={{ .RHNUser }} --password={{ .RHNPassword }}
+{{- if .RHNActivationKey }}
+subscription-manager register --force --org="${RHN_ORG_ID}" --activationkey="${RHN_ACTIVATION_KEY}"
+{{- else }}
+# The password is prompted without the controlling terminal to read it from the stdin and keep it off the arguments
+printf '%s\n' "${RHN_PASSWORD}" | setsid -w subscription-manager register --force --auto-attach --username="${RHN_USER}"
+{{- end }}
 {{end}}
 {{if .RootPasswd }}
-echo {{ .RootPasswd }} | passwd root --stdin
+printf '%s\n' "${ROOT_PASSWORD}" | passwd root --stdin
 {{end}}
 yum update -y && yum install -y yum-utils
 yum install -y cloud-init
@@ -68,6 +73,7 @@ EOF
 sed -i 's/GRUB_TIMEOUT=.*$/GRUB_TIMEOUT=60/g' /etc/default/grub
 sed -i 's/GRUB_CMDLINE_LINUX=.*$/GRUB_CMDLINE_LINUX="console=tty0 console=hvc0,115200n8  biosdevname=0  crashkernel=auto rd.shell rd.debug rd.driver.pre=dm_multipath log_buf_len=1M "/g' /etc/default/grub
 echo 'force_drivers+=" dm-multipath "' >/etc/dracut.conf.d/10-mp.conf
+{{ .Customization }}
 dracut --regenerate-all --force
 for kernel in $(rpm -q kernel | sort -V | sed 's/kernel-//')
 do
@@ -94,7 +100,7 @@ users:
 
 ## Change 1: Enabling the root login
 disable_root: 0
-ssh_pwauth:   0
+ssh_pwauth:   {{ if .SSHPwauth }}1{{ else }}0{{ end }}
 
 mount_default_fields: [~, ~, 'auto', 'defaults,nofail,x-systemd.requires=cloud-init.service', '0', '2']
 resize_rootfs_tmp: /dev
@@ -117,8 +123,8 @@ cloud_init_modules:
  - users-groups
  - ssh
 
-Set the default timezone to UTC:
-timezone: UTC
+# Set the default timezone
+timezone: {{ .Timezone }}
 cloud_config_modules:
  - mounts
  - locale
@@ -171,12 +177,15 @@ write_files:
 runcmd:
  - bash /tmp/update-disks.sh
  - xfs_growfs -d /
+{{- range .RunCmd }}
+ - {{ toJSON . }}
+{{- end }}
 
 ### ^^^ Change 2: Recommendation from PowerVC
 
 system_info:
   default_user:
-    name: cloud-user
+    name: {{ .DefaultUser }}
     lock_passwd: true
     gecos: Cloud User
     groups: [adm, systemd-journal]
@@ -190,7 +199,7 @@ system_info:
 
 ###############################################
 ### Change 3: Recommendation from PowerVC######
-datasource_list: [ ConfigDrive, NoCloud, None ]
+datasource_list: [ {{ join .DatasourceList ", " }} ]
 datasource:
   ConfigDrive:
     dsmode: local
@@ -202,13 +211,33 @@ datasource:
 var dsIdentify = `policy: search,found=all,maybe=all,notfound=disabled
 `
 
+// Setup holds the values for rendering the SetupTemplate, the secrets are passed to the setup script
+// through the environment variables returned by Env instead of rendering them into the script
 type Setup struct {
 	Dist, RHNUser, RHNPassword, RootPasswd string
+	RHNActivationKey, RHNOrgID             string
+	// Customization is the rendered ImageCustomization
+	Customization string
 }
 
-func Render(dist, rhnuser, rhnpasswd, rootpasswd string) (string, error) {
-	s := Setup{
-		dist, rhnuser, rhnpasswd, rootpasswd,
+// Env returns the environment variables referred by the SetupTemplate
+func (s Setup) Env() []string {
+	return []string{
+		"RHN_USER=" + s.RHNUser,
+		"RHN_PASSWORD=" + s.RHNPassword,
+		"RHN_ACTIVATION_KEY=" + s.RHNActivationKey,
+		"RHN_ORG_ID=" + s.RHNOrgID,
+		"ROOT_PASSWORD=" + s.RootPasswd,
+	}
+}
+
+func Render(s Setup) (string, error) {
+	if ImageCustomization != nil {
+		c, err := ImageCustomization.Render()
+		if err != nil {
+			return "", err
+		}
+		s.Customization = c
 	}
 	var wr bytes.Buffer
 	t := template.Must(template.New("setup").Parse(SetupTemplate))
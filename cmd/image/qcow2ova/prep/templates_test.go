@@ -20,42 +20,52 @@ import (
 )
 
 func TestRender(t *testing.T) {
-	type args struct {
-		dist       string
-		rhnuser    string
-		rhnpasswd  string
-		rootpasswd string
-	}
 	tests := []struct {
 		name    string
-		args    args
+		args    Setup
 		want    string
 		wantErr bool
 		notwant string
 	}{
 		{
 			name:    "rhel image",
-			args:    args{"rhel", "rhn", "rhnpassword", "some-password"},
+			args:    Setup{Dist: "rhel", RHNUser: "rhn", RHNPassword: "rhnpassword", RootPasswd: "some-password"},
 			want:    "subscription-manager",
 			wantErr: false,
+			notwant: "rhnpassword",
 		},
 		{
 			name:    "centos image",
-			args:    args{dist: "centos", rootpasswd: "some-password"},
-			want:    "some-password",
+			args:    Setup{Dist: "centos", RootPasswd: "some-password"},
+			want:    "passwd root --stdin",
 			wantErr: false,
+			notwant: "some-password",
 		},
 		{
 			name:    "rhel image without root password",
-			args:    args{dist: "rhel", rhnuser: "rhn", rhnpasswd: "rhnpassword"},
+			args:    Setup{Dist: "rhel", RHNUser: "rhn", RHNPassword: "rhnpassword"},
 			want:    "subscription-manager",
 			wantErr: false,
 			notwant: "passwd root",
 		},
+		{
+			name:    "rhel image password off the arguments",
+			args:    Setup{Dist: "rhel", RHNUser: "rhn", RHNPassword: "rhnpassword"},
+			want:    `printf '%s\n' "${RHN_PASSWORD}" | setsid -w subscription-manager register`,
+			wantErr: false,
+			notwant: "--password",
+		},
+		{
+			name:    "rhel image with activation key",
+			args:    Setup{Dist: "rhel", RHNActivationKey: "some-key", RHNOrgID: "1234"},
+			want:    `--activationkey="${RHN_ACTIVATION_KEY}"`,
+			wantErr: false,
+			notwant: "some-key",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := Render(tt.args.dist, tt.args.rhnuser, tt.args.rhnpasswd, tt.args.rootpasswd)
+			got, err := Render(tt.args)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Render() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -69,3 +79,113 @@ func TestRender(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderWithCustomization(t *testing.T) {
+	ImageCustomization = &Customization{Packages: []string{"vim"}}
+	defer func() { ImageCustomization = nil }()
+	got, err := Render(Setup{Dist: "centos"})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	custom := strings.Index(got, "yum install -y 'vim'")
+	if custom == -1 {
+		t.Fatalf("Render() %s does not contain the customization", got)
+	}
+	if custom > strings.Index(got, "grub2-mkconfig") {
+		t.Errorf("Render() customization is applied after the grub2-mkconfig")
+	}
+}
+
+func TestRenderCloudConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		set     []string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "default cloud config",
+			want: []string{"name: cloud-user", "timezone: UTC", "ssh_pwauth:   0", "datasource_list: [ ConfigDrive, NoCloud, None ]"},
+		},
+		{
+			name: "override the variables",
+			set:  []string{"default_user=admin", "timezone=Asia/Kolkata", "ssh_pwauth=true", "datasource_list=ConfigDrive, None", "runcmd=echo 'a: b' > /tmp/x", "runcmd=touch /tmp/y"},
+			want: []string{"name: admin", "timezone: Asia/Kolkata", "ssh_pwauth:   1", "datasource_list: [ ConfigDrive, None ]", ` - "echo 'a: b' > /tmp/x"`, ` - "touch /tmp/y"`},
+		},
+		{
+			name:    "unknown variable",
+			set:     []string{"hostname=foo"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid format",
+			set:     []string{"timezone"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid default user",
+			set:     []string{"default_user=admin\nfoo: bar"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			CloudConfigValues = DefaultCloudConfigVars()
+			defer func() { CloudConfigValues = DefaultCloudConfigVars() }()
+			var err error
+			for _, kv := range tt.set {
+				if err = CloudConfigValues.Set(kv); err != nil {
+					break
+				}
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Set() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			got, err := RenderCloudConfig()
+			if err != nil {
+				t.Fatalf("RenderCloudConfig() error = %v", err)
+			}
+			for _, w := range tt.want {
+				if !strings.Contains(got, w) {
+					t.Errorf("RenderCloudConfig() %s does not contain the %s", got, w)
+				}
+			}
+		})
+	}
+}
+
+func TestRenderCloudConfigInvalidYAML(t *testing.T) {
+	defer func(c string) { CloudConfig = c }(CloudConfig)
+	CloudConfig = "users:\n - default\n  timezone: {{ .Timezone }}\n"
+	if _, err := RenderCloudConfig(); err == nil {
+		t.Errorf("RenderCloudConfig() expected an error for the invalid YAML")
+	}
+}
+
+func TestRenderUserCloudConfig(t *testing.T) {
+	defer func() { UserCloudConfig = "" }()
+	tests := []struct {
+		name    string
+		config  string
+		wantErr bool
+	}{
+		{name: "raw config with the braces", config: "#cloud-config\nbootcmd:\n - echo '{{ .NotAVariable }}'\n"},
+		{name: "cloud-init jinja template", config: "## template: jinja\n#cloud-config\nhostname: {{ v1.instance_id }}\n"},
+		{name: "invalid YAML", config: "users:\n - default\n  timezone: UTC\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			UserCloudConfig = tt.config
+			got, err := RenderCloudConfig()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RenderCloudConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.config {
+				t.Errorf("RenderCloudConfig() = %s, want the cloud config as is", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package qcow2ova
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"github.com/ppc64le-cloud/pvsadm/cmd/image/qcow2ova/ova"
+	"github.com/ppc64le-cloud/pvsadm/cmd/image/qcow2ova/prep"
+)
+
+// buildProvenance returns the build provenance of the image recorded in the OVF, image is the downloaded source image
+func buildProvenance(srcUrl, image, dist string) (ova.Provenance, error) {
+	checksum, err := sha256sum(image)
+	if err != nil {
+		return ova.Provenance{}, fmt.Errorf("failed to calculate the checksum of the source image, err: %v", err)
+	}
+	p := ova.Provenance{
+		SourceURL:      sanitizeURL(srcUrl),
+		SourceChecksum: "sha256:" + checksum,
+		Distro:         dist,
+		BuildTimestamp: time.Now().UTC().Format(time.RFC3339),
+	}
+	if p.BuildHost, err = os.Hostname(); err != nil {
+		klog.V(1).Infof("Failed to get the hostname, err: %v", err)
+	}
+	if dist != "coreos" {
+		p.PrepTemplateHash = hash(prep.SetupTemplate)
+		cloudConfig, err := prep.RenderCloudConfig()
+		if err != nil {
+			return ova.Provenance{}, err
+		}
+		p.CloudConfigHash = hash(cloudConfig)
+	}
+	return p, nil
+}
+
+// sanitizeURL removes the credentials, query and fragment from the URL as they may carry the auth tokens, the local
+// file path is converted into the absolute path
+func sanitizeURL(srcUrl string) string {
+	if !isURL(srcUrl) {
+		if abs, err := filepath.Abs(srcUrl); err == nil {
+			return abs
+		}
+		return srcUrl
+	}
+	u, err := url.Parse(srcUrl)
+	if err != nil {
+		return ""
+	}
+	u.User = nil
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String()
+}
+
+func hash(s string) string {
+	return fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(s)))
+}
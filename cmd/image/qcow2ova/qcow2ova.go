@@ -19,14 +19,17 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"sort"
 	"strings"
 	"syscall"
 
 	"github.com/manifoldco/promptui"
 	"github.com/ppc64le-cloud/pvsadm/cmd/image/qcow2ova/ova"
 	"github.com/ppc64le-cloud/pvsadm/cmd/image/qcow2ova/prep"
+	"github.com/ppc64le-cloud/pvsadm/cmd/image/qcow2ova/qemuimg"
 	"github.com/ppc64le-cloud/pvsadm/cmd/image/qcow2ova/validate"
 	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/cache"
 	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
 	"github.com/spf13/cobra"
 	"k8s.io/klog/v2"
@@ -37,6 +40,8 @@ var Cmd = &cobra.Command{
 	Short: "Convert the qcow2 image to ova format",
 	Long: `Convert the qcow2 image to ova format
 
+The format of the source image is detected with the qemu-img, the raw, vmdk, vhd and vhdx images are converted as well.
+
 Examples:
 
   # Downloads the coreos image from remote site and converts into ova type with name rhcos-461.ova.gz
@@ -45,8 +50,20 @@ Examples:
   # Converts the CentOS image from the local filesystem with size 50GB
   pvsadm image qcow2ova --image-name centos-82 --image-dist centos --image-size 50 --image-url /root/CentOS-8-GenericCloud-8.2.2004-20200611.2.ppc64le.qcow2
 
+  # Converts the CentOS image in the vmdk format from the local filesystem
+  pvsadm image qcow2ova --image-name centos-9 --image-dist centos --image-url /root/centos-9.vmdk
+
   # Converts the RHEL image from local filesystem
-  pvsadm image qcow2ova --image-name rhel-82-29oct --image-dist rhel --rhn-user joesmith@example.com --rhn-password someValidPassword --image-url ./rhel-8.2-update-2-ppc64le-kvm.qcow2
+  pvsadm image qcow2ova --image-name rhel-82-29oct --image-dist rhel --rhn-user joesmith@example.com --rhn-password-file ./rhn-password --image-url ./rhel-8.2-update-2-ppc64le-kvm.qcow2
+
+  # Converts the RHEL image registered with the activation key read from the stdin
+  cat ./activation-key | pvsadm image qcow2ova --image-name rhel-82-29oct --image-dist rhel --rhn-org-id 1234567 --rhn-activation-key-file - --image-url ./rhel-8.2-update-2-ppc64le-kvm.qcow2
+
+  # Downloads the CentOS image from the IBM Cloud Object Storage bucket using the HMAC keys
+  pvsadm image qcow2ova --image-name centos-9 --image-dist centos --image-url s3://images/CentOS-Stream-GenericCloud-9-latest.ppc64le.qcow2 --bucket-region us-south --accesskey <ACCESSKEY> --secretkey <SECRETKEY>
+
+  # Downloads the image from the remote site and verifies the checksum
+  pvsadm image qcow2ova --image-name centos-9 --image-dist centos --image-url https://cloud.centos.org/centos/9-stream/ppc64le/images/CentOS-Stream-GenericCloud-9-latest.ppc64le.qcow2 --image-checksum sha256:<CHECKSUM>
 
   # Converts the CentOS image from the local filesystem with OS password set
   pvsadm image qcow2ova --image-name centos-82 --image-dist centos --os-password s0meC0mplexPassword --image-url /root/CentOS-8-GenericCloud-8.2.2004-20200611.2.ppc64le.qcow2
@@ -61,11 +78,17 @@ Examples:
   # Step 3 - Run the qcow2ova with the modified image preparation template
   pvsadm image qcow2ova --image-name centos-82 --image-dist centos --image-url /root/CentOS-8-GenericCloud-8.2.2004-20200611.2.ppc64le.qcow2 --prep-template image-prep.template
  
-  # Customize the cloud config and Convert image with user defined cloud config template.
-  # Step 1 - Dump the default cloud config template
+  # Customize the image declaratively on top of the default image preparation template, see the docs for the customization YAML format
+  pvsadm image qcow2ova --image-name centos-82 --image-dist centos --image-url /root/CentOS-8-GenericCloud-8.2.2004-20200611.2.ppc64le.qcow2 --customization customization.yaml
+
+  # Change the default user and append a command to the cloud config
+  pvsadm image qcow2ova --image-name centos-82 --image-dist centos --image-url /root/CentOS-8-GenericCloud-8.2.2004-20200611.2.ppc64le.qcow2 --cloud-config-set default_user=admin --cloud-config-set timezone=Asia/Kolkata --cloud-config-set 'runcmd=echo hello > /tmp/hello'
+
+  # Customize the cloud config and Convert image with user defined cloud config.
+  # Step 1 - Dump the default cloud config
   pvsadm image qcow2ova --cloud-config-default > user_cloud.config
-  # Step 2 - Make the necessary changes to the above generated template file - user_cloud.config
-  # Step 3 - Run the qcow2ova with the modified cloud config template
+  # Step 2 - Make the necessary changes to the above generated file - user_cloud.config
+  # Step 3 - Run the qcow2ova with the modified cloud config
   pvsadm image qcow2ova --image-name centos-82 --image-dist centos --image-url /root/CentOS-8-GenericCloud-8.2.2004-20200611.2.ppc64le.qcow2 --cloud-config user_cloud.config
 
 
@@ -79,18 +102,14 @@ Qcow2 images location:
   # RHCOS images: https://mirror.openshift.com/pub/openshift-v4/ppc64le/dependencies/rhcos/
 
 `,
-	PreRunE: func(cmd *cobra.Command, args []string) error {
+	PreRunE: func(cmd *cobra.Command, args []string) (err error) {
+		defer func() { err = utils.RedactError(err) }()
 		opt := pkg.ImageCMDOptions
 		if opt.PrepTemplateDefault {
 			fmt.Println(prep.SetupTemplate)
 			os.Exit(0)
 		}
 
-		if opt.CloudConfigDefault {
-			fmt.Println(prep.CloudConfig)
-			os.Exit(0)
-		}
-
 		// Override the prep.SetupTemplate if --prep-template supplied
 		if opt.PrepTemplate != "" {
 			if strings.ToLower(opt.ImageDist) == "coreos" {
@@ -104,24 +123,85 @@ Qcow2 images location:
 				prep.SetupTemplate = string(content)
 			}
 		}
+		if opt.Customization != "" {
+			if strings.ToLower(opt.ImageDist) == "coreos" {
+				return fmt.Errorf("--customization option is not supported for coreos distro")
+			}
+			if !strings.Contains(prep.SetupTemplate, ".Customization") {
+				return fmt.Errorf("--customization option requires the {{ .Customization }} placeholder in the image preparation template")
+			}
+			klog.V(2).Infof("Applying the image customization from %s", opt.Customization)
+			c, err := prep.LoadCustomization(opt.Customization)
+			if err != nil {
+				return err
+			}
+			prep.ImageCustomization = c
+		}
+		if opt.CloudConfig != "" && (opt.CloudConfigTemplate != "" || len(opt.CloudConfigSet) != 0) {
+			return fmt.Errorf("--cloud-config is written as is and can't be used along with the --cloud-config-template and --cloud-config-set")
+		}
 		if opt.CloudConfig != "" {
 			klog.V(2).Info("Overriding with the user defined cloud config.")
 			content, err := os.ReadFile(opt.CloudConfig)
 			if err != nil {
 				return err
 			}
+			prep.UserCloudConfig = string(content)
+		}
+		if opt.CloudConfigTemplate != "" {
+			klog.V(2).Info("Overriding with the user defined cloud config template.")
+			content, err := os.ReadFile(opt.CloudConfigTemplate)
+			if err != nil {
+				return err
+			}
 			prep.CloudConfig = string(content)
-
+		}
+		for _, kv := range opt.CloudConfigSet {
+			if err := prep.CloudConfigValues.Set(kv); err != nil {
+				return err
+			}
+		}
+		cloudConfig, err := prep.RenderCloudConfig()
+		if err != nil {
+			return err
+		}
+		if opt.CloudConfigDefault {
+			fmt.Print(cloudConfig)
+			os.Exit(0)
 		}
 		if !utils.Contains([]string{"rhel", "centos", "coreos"}, strings.ToLower(opt.ImageDist)) {
 			klog.Errorln("--image-dist is a mandatory flag and one of these [rhel, centos, coreos]")
 			os.Exit(1)
 		}
 
+		r := &secretReader{stdin: os.Stdin}
+		if opt.RHNPassword, err = r.read("rhn-password", opt.RHNPassword, opt.RHNPasswordFile, envRHNPassword); err != nil {
+			return err
+		}
+		if opt.RHNActivationKey, err = r.read("rhn-activation-key", opt.RHNActivationKey, opt.RHNActivationKeyFile, envRHNActivationKey); err != nil {
+			return err
+		}
+		if opt.OSPassword, err = r.read("os-password", opt.OSPassword, opt.OSPasswordFile, envOSPassword); err != nil {
+			return err
+		}
+		for _, secret := range []string{opt.ImageURLToken, opt.ImageURLPassword, opt.SecretKey} {
+			utils.RegisterSecret(secret)
+		}
+		// Mask the secrets in the logs
+		klog.SetLogFilter(utils.RedactFilter{})
+
+		if opt.ImageDist == "rhel" && opt.RHNActivationKey != "" {
+			if opt.RHNOrgID == "" {
+				return fmt.Errorf("--rhn-org-id is required along with the --rhn-activation-key")
+			}
+			if opt.RHNUser != "" || opt.RHNPassword != "" {
+				return fmt.Errorf("--rhn-activation-key can't be used along with the --rhn-user and --rhn-password")
+			}
+		}
+
 		//Read the RHNUser and RHNPassword if empty
-		if opt.ImageDist == "rhel" && (opt.RHNUser == "" || opt.RHNPassword == "") {
-			var err error
-			klog.Warning("rhn-user and rhn-password options are mandatory when image-dist is rhel, please enter the details")
+		if opt.ImageDist == "rhel" && opt.RHNActivationKey == "" && (opt.RHNUser == "" || opt.RHNPassword == "") {
+			klog.Warning("rhn-user and rhn-password or rhn-activation-key and rhn-org-id options are mandatory when image-dist is rhel, please enter the details")
 
 			//Validates and make sure input is not an empty string
 			validate := func(input string) error {
@@ -153,36 +233,35 @@ Qcow2 images location:
 				if err != nil {
 					return err
 				}
+				utils.RegisterSecret(opt.RHNPassword)
 			}
 		}
 
 		if opt.ImageDist != "coreos" && opt.OSPassword == "" && !opt.OSPasswordSkip {
-			var err error
 			opt.OSPassword, err = GeneratePassword(12)
 			if err != nil {
 				return err
 			}
-			passwordfile, err := os.Create("password.txt")
-			if err != nil {
-				klog.Errorf("failed to create password file, err: %v", err)
-				return err
-			}
-			defer passwordfile.Close()
-
-			passwordfile.WriteString(fmt.Sprintf("Root Password : %s", opt.OSPassword))
-			cwd, err := os.Getwd()
-			if err != nil {
-				return err
+			utils.RegisterSecret(opt.OSPassword)
+			if opt.GeneratedPasswordFile == "" {
+				klog.Warning("Autogenerated OS root password is not saved as --generated-password-file is empty")
+			} else {
+				if err := writePasswordFile(opt.GeneratedPasswordFile, opt.OSPassword); err != nil {
+					return err
+				}
+				passwordFile, err := filepath.Abs(opt.GeneratedPasswordFile)
+				if err != nil {
+					return err
+				}
+				klog.Infof("Autogenerated OS root password is updated in %s", passwordFile)
 			}
-
-			klog.Infof("Autogenerated OS root password is updated in %s", filepath.Join(cwd, passwordfile.Name()))
-
 		}
 
 		// preflight checks validations
 		return validate.Validate()
 	},
-	RunE: func(cmd *cobra.Command, args []string) error {
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		defer func() { err = utils.RedactError(err) }()
 		opt := pkg.ImageCMDOptions
 
 		tmpDir, err := os.MkdirTemp(opt.TempDir, "qcow2ova")
@@ -222,7 +301,12 @@ Qcow2 images location:
 
 		klog.V(1).Infof("downloaded/copied the file at: %s", image)
 
-		var qcow2Img string
+		provenance, err := buildProvenance(opt.ImageURL, image, opt.ImageDist)
+		if err != nil {
+			return err
+		}
+
+		var srcImg string
 
 		checkGzip, err := utils.IsGzip(image)
 		if err != nil {
@@ -230,15 +314,21 @@ Qcow2 images location:
 		}
 		if checkGzip {
 			klog.V(1).Infof("Image %s is in gzip format, extracting it", image)
-			qcow2Img = filepath.Join(tmpDir, ova.VolName+".qcow2")
-			err = utils.GunzipIt(image, qcow2Img)
+			srcImg = filepath.Join(tmpDir, ova.VolName+".img")
+			err = utils.GunzipIt(image, srcImg)
 			if err != nil {
 				return err
 			}
 			klog.V(1).Info("Extract complete")
 		} else {
-			qcow2Img = image
+			srcImg = image
+		}
+
+		srcFormat, err := qemuimg.DetectFormat(srcImg)
+		if err != nil {
+			return err
 		}
+		klog.V(1).Infof("Image %s is in %s format", srcImg, qemuimg.FormatName(srcFormat))
 
 		ovaImgDir := filepath.Join(tmpDir, "ova-img-dir")
 		err = os.Mkdir(ovaImgDir, 0755)
@@ -248,22 +338,30 @@ Qcow2 images location:
 		volumeDiskName := fmt.Sprintf("%s-%s", opt.ImageName, ova.VolNameRaw)
 		rawImg := filepath.Join(ovaImgDir, volumeDiskName)
 
-		klog.Infof("Converting Qcow2(%s) image to raw(%s) format", qcow2Img, rawImg)
-		err = qemuImgConvertQcow2Raw(qcow2Img, rawImg)
+		klog.Infof("Converting %s(%s) image to raw(%s) format", qemuimg.FormatName(srcFormat), srcImg, rawImg)
+		err = qemuimg.Convert(srcImg, srcFormat, rawImg, "raw")
 		if err != nil {
 			return err
 		}
 		klog.Info("Conversion completed")
 
 		klog.Infof("Resizing the image %s to %dG", rawImg, opt.ImageSize)
-		err = qemuImgResize("-f", "raw", rawImg, fmt.Sprintf("%dG", opt.ImageSize))
+		err = qemuimg.Resize("-f", "raw", rawImg, fmt.Sprintf("%dG", opt.ImageSize))
 		if err != nil {
 			return err
 		}
 		klog.Info("Resize completed")
 
 		klog.Info("Preparing the image")
-		err = prep.Prepare4capture(mnt, rawImg, opt.ImageDist, opt.RHNUser, opt.RHNPassword, opt.OSPassword)
+		setup := prep.Setup{
+			Dist:             opt.ImageDist,
+			RHNUser:          opt.RHNUser,
+			RHNPassword:      opt.RHNPassword,
+			RHNActivationKey: opt.RHNActivationKey,
+			RHNOrgID:         opt.RHNOrgID,
+			RootPasswd:       opt.OSPassword,
+		}
+		err = prep.Prepare4capture(mnt, rawImg, setup)
 		if err != nil {
 			return fmt.Errorf("failed while preparing the image for %s distro, err: %v", opt.ImageDist, err)
 		}
@@ -271,7 +369,7 @@ Qcow2 images location:
 
 		klog.Info("Creating an OVA bundle")
 		ovafile := filepath.Join(tmpDir, opt.ImageName+".ova")
-		if err := ova.CreateTarArchive(ovaImgDir, ovafile, opt.TargetDiskSize, opt.ImageDist, volumeDiskName); err != nil {
+		if err := ova.CreateTarArchive(ovaImgDir, ovafile, opt.TargetDiskSize, opt.ImageDist, volumeDiskName, provenance); err != nil {
 			return fmt.Errorf("failed to create ova bundle, err: %v", err)
 		}
 		klog.Infof("OVA bundle creation completed: %s", ovafile)
@@ -284,29 +382,63 @@ Qcow2 images location:
 		}
 		klog.Info("OVA file Compression completed")
 
-		fmt.Printf("\n\nSuccessfully converted Qcow2 image to OVA format, find at %s\nOS root password: %s\n", ovaGZfile, opt.OSPassword)
+		fmt.Printf("\n\nSuccessfully converted the image to OVA format, find at %s\n", ovaGZfile)
 		return nil
 	},
 }
 
 func init() {
 	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.ImageName, "image-name", "", "Name of the resultant OVA image")
-	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.ImageURL, "image-url", "", "URL or absolute local file path to the <QCOW2>.gz image")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.ImageURL, "image-url", "", "URL(http, https, s3://<bucket>/<object>, cos://<cos-instance-name>/<bucket>/<object>) or absolute local file path to the image, supported formats: qcow2, raw, vmdk, vhd and vhdx, optionally gzip compressed")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.ImageChecksum, "image-checksum", "", "Checksum of the image in sha256:<checksum> format, looked up from the CHECKSUM or SHA256SUMS file next to the --image-url if not set")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.ImageURLToken, "image-url-token", "", "Bearer token for downloading the image from the --image-url")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.ImageURLUser, "image-url-user", "", "Username for the basic authentication while downloading the image from the --image-url")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.ImageURLPassword, "image-url-password", "", "Password for the basic authentication while downloading the image from the --image-url")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.ImageProxy, "image-proxy", "", "Proxy URL for downloading the image(default: HTTP_PROXY/HTTPS_PROXY environment variables)")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.ImageCacheDir, "image-cache-dir", cache.DefaultDir(), "Directory to cache the images downloaded over http(s) across the runs")
+	Cmd.Flags().Int64Var(&pkg.ImageCMDOptions.ImageCacheMaxSize, "image-cache-max-size", 50, "Maximum size (in GB) of the image cache, least recently used images are evicted beyond this size(0 for unlimited)")
+	Cmd.Flags().BoolVar(&pkg.ImageCMDOptions.NoImageCache, "no-image-cache", false, "Always download the image and don't add it to the image cache")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.AccessKey, "accesskey", "", "Cloud Object Storage HMAC access key, required for the s3://<bucket>/<object> --image-url")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.SecretKey, "secretkey", "", "Cloud Object Storage HMAC secret key, required for the s3://<bucket>/<object> --image-url")
+	Cmd.Flags().StringVar(&bucketRegion, "bucket-region", "us-south", "Cloud Object Storage bucket region for the s3:// and cos:// --image-url")
 	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.ImageDist, "image-dist", "", "Image Distribution(supported: rhel, centos, coreos)")
 	Cmd.Flags().Uint64Var(&pkg.ImageCMDOptions.ImageSize, "image-size", 11, "Size (in GB) of the resultant OVA image")
 	Cmd.Flags().Int64Var(&pkg.ImageCMDOptions.TargetDiskSize, "target-disk-size", 120, "Size (in GB) of the target disk volume where OVA will be copied")
 	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.RHNUser, "rhn-user", "", "RedHat Subscription username. Required when Image distribution is rhel")
-	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.RHNPassword, "rhn-password", "", "RedHat Subscription password. Required when Image distribution is rhel")
-	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.OSPassword, "os-password", "", "Root user password, will auto-generate the 12 bits password(applicable only for redhat and cento distro)")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.RHNPassword, "rhn-password", "", "RedHat Subscription password. Required when Image distribution is rhel(env name: "+envRHNPassword+")")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.RHNPasswordFile, "rhn-password-file", "", "File to read the RedHat Subscription password from, - to read from the stdin")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.RHNActivationKey, "rhn-activation-key", "", "RedHat Subscription activation key, used along with the --rhn-org-id instead of the --rhn-user and --rhn-password(env name: "+envRHNActivationKey+")")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.RHNActivationKeyFile, "rhn-activation-key-file", "", "File to read the RedHat Subscription activation key from, - to read from the stdin")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.RHNOrgID, "rhn-org-id", "", "RedHat Subscription organization ID. Required with the --rhn-activation-key")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.OSPassword, "os-password", "", "Root user password, will auto-generate the 12 bits password(applicable only for redhat and cento distro)(env name: "+envOSPassword+")")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.OSPasswordFile, "os-password-file", "", "File to read the root user password from, - to read from the stdin")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.GeneratedPasswordFile, "generated-password-file", "password.txt", "File to write the autogenerated root user password with 0600 permissions, set to empty to not save the password")
 	Cmd.Flags().StringVarP(&pkg.ImageCMDOptions.TempDir, "temp-dir", "t", os.TempDir(), "Scratch space to use for OVA generation")
 	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.PrepTemplate, "prep-template", "", "Image preparation script template, use --prep-template-default to print the default template(supported distros: rhel and centos)")
 	Cmd.Flags().BoolVar(&pkg.ImageCMDOptions.PrepTemplateDefault, "prep-template-default", false, "Prints the default image preparation script template, use --prep-template to set the custom template script(supported distros: rhel and centos)")
 	Cmd.Flags().StringSliceVar(&pkg.ImageCMDOptions.PreflightSkip, "skip-preflight-checks", []string{}, "Skip the preflight checks(e.g: diskspace, platform, tools) - dev-only option")
 	Cmd.Flags().BoolVar(&pkg.ImageCMDOptions.OSPasswordSkip, "skip-os-password", false, "Skip the root user password")
-	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.CloudConfig, "cloud-config", "", "Set the custom cloud config, use --cloud-config-default to print the default cloud config")
-	Cmd.Flags().BoolVar(&pkg.ImageCMDOptions.CloudConfigDefault, "cloud-config-default", false, "Prints the default cloud config template, use --cloud-config to set the custom cloud config template")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.CloudConfig, "cloud-config", "", "Set the custom cloud config written as is, use --cloud-config-default to print the default cloud config")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.CloudConfigTemplate, "cloud-config-template", "", "Set the custom cloud config template rendered with the --cloud-config-set variables")
+	Cmd.Flags().StringVar(&pkg.ImageCMDOptions.Customization, "customization", "", "Image customization YAML(repos, packages, files, services, kernel args, users and scripts) applied on top of the image preparation template(supported distros: rhel and centos)")
+	Cmd.Flags().StringArrayVar(&pkg.ImageCMDOptions.CloudConfigSet, "cloud-config-set", nil, "Set the cloud config template variable in key=value format, can be used multiple times. Supported variables:\n"+cloudConfigKeysUsage())
+	Cmd.Flags().BoolVar(&pkg.ImageCMDOptions.CloudConfigDefault, "cloud-config-default", false, "Prints the default cloud config rendered with the --cloud-config-set variables, use --cloud-config to set the custom cloud config")
 	_ = Cmd.Flags().MarkHidden("skip-preflight-checks")
 	_ = Cmd.MarkFlagRequired("image-name")
 	_ = Cmd.MarkFlagRequired("image-url")
 	Cmd.Flags().SortFlags = false
 }
+
+// cloudConfigKeysUsage returns the usage of the cloud config variables sorted by the name
+func cloudConfigKeysUsage() string {
+	var keys []string
+	for k := range prep.CloudConfigKeys {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var usage []string
+	for _, k := range keys {
+		usage = append(usage, fmt.Sprintf("  %s: %s", k, prep.CloudConfigKeys[k]))
+	}
+	return strings.Join(usage, "\n")
+}
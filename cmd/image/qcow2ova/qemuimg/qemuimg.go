@@ -0,0 +1,114 @@
+// Copyright 2021 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package qemuimg
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+const QemuCMD = "qemu-img"
+
+// SupportedFormats are the source image formats converted to the OVA keyed by the qemu-img format name
+var SupportedFormats = map[string]string{
+	"qcow2": "qcow2",
+	"raw":   "raw",
+	"vmdk":  "vmdk",
+	"vpc":   "vhd",
+	"vhdx":  "vhdx",
+}
+
+// Info is the image information reported by the qemu-img info command
+type Info struct {
+	Format      string `json:"format"`
+	VirtualSize int64  `json:"virtual-size"`
+	ActualSize  int64  `json:"actual-size"`
+}
+
+// ParseInfo parses the qemu-img info --output=json output
+func ParseInfo(data []byte) (*Info, error) {
+	info := &Info{}
+	if err := json.Unmarshal(data, info); err != nil {
+		return nil, fmt.Errorf("failed to parse the qemu-img info output, err: %v", err)
+	}
+	if info.Format == "" {
+		return nil, fmt.Errorf("image format is not found in the qemu-img info output")
+	}
+	return info, nil
+}
+
+// GetInfo returns the information of the image
+func GetInfo(file string) (*Info, error) {
+	exit, out, err := utils.RunCMD(QemuCMD, "info", "--output=json", file)
+	if exit != 0 {
+		return nil, fmt.Errorf("failed to get the info of the image(%s), exited with: %d, out: %s, err: %s", file, exit, out, err)
+	}
+	return ParseInfo([]byte(out))
+}
+
+// DetectFormat returns the qemu-img format of the image, errors out for the formats not in SupportedFormats
+func DetectFormat(file string) (string, error) {
+	info, err := GetInfo(file)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := SupportedFormats[info.Format]; !ok {
+		return "", fmt.Errorf("unsupported image format %s of the image %s, supported: %s", info.Format, file, supportedFormats())
+	}
+	return info.Format, nil
+}
+
+// Convert converts the source image in the sourceFormat to the target image in the targetFormat, opts are the
+// additional qemu-img convert options, e.g: -c to compress the qcow2 image
+func Convert(source, sourceFormat, target, targetFormat string, opts ...string) error {
+	args := append([]string{"convert", "-f", sourceFormat, "-O", targetFormat}, opts...)
+	args = append(args, source, target)
+	exit, out, err := utils.RunCMD(QemuCMD, args...)
+	if exit != 0 {
+		return fmt.Errorf("failed to convert %s(%s) image to %s(%s) format, exited with: %d, out: %s, err: %s", FormatName(sourceFormat), source, FormatName(targetFormat), target, exit, out, err)
+	}
+	return nil
+}
+
+// Resize resizes the image
+func Resize(args ...string) error {
+	args = append([]string{"resize"}, args...)
+	exit, out, err := utils.RunCMD(QemuCMD, args...)
+	if exit != 0 {
+		return fmt.Errorf("failed to resize image(%s), exited with: %d, out: %s, err: %s", args, exit, out, err)
+	}
+	return nil
+}
+
+// FormatName returns the user facing name of the qemu-img format, e.g: vhd for the vpc
+func FormatName(format string) string {
+	if name, ok := SupportedFormats[format]; ok {
+		return name
+	}
+	return format
+}
+
+func supportedFormats() string {
+	var names []string
+	for _, name := range SupportedFormats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package qemuimg
+
+import "testing"
+
+func TestParseInfo(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       string
+		wantFormat string
+		wantErr    bool
+	}{
+		{
+			name:       "qcow2 image",
+			data:       `{"virtual-size": 10737418240, "filename": "centos.qcow2", "format": "qcow2", "actual-size": 1006632960, "dirty-flag": false}`,
+			wantFormat: "qcow2",
+		},
+		{
+			name:       "vhd image",
+			data:       `{"virtual-size": 10737418240, "filename": "centos.vhd", "format": "vpc", "actual-size": 1006632960}`,
+			wantFormat: "vpc",
+		},
+		{
+			name:    "missing format",
+			data:    `{"virtual-size": 10737418240}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid output",
+			data:    `qemu-img: Could not open 'centos.qcow2'`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseInfo([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseInfo() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.Format != tt.wantFormat {
+				t.Errorf("ParseInfo() format = %v, want %v", got.Format, tt.wantFormat)
+			}
+		})
+	}
+}
+
+func TestFormatName(t *testing.T) {
+	for format, want := range map[string]string{"vpc": "vhd", "qcow2": "qcow2", "qed": "qed"} {
+		if got := FormatName(format); got != want {
+			t.Errorf("FormatName(%s) = %v, want %v", format, got, want)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package qcow2ova
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+const (
+	envRHNPassword      = "PVSADM_RHN_PASSWORD"
+	envRHNActivationKey = "PVSADM_RHN_ACTIVATION_KEY"
+	envOSPassword       = "PVSADM_OS_PASSWORD"
+)
+
+// secretReader reads the secrets from the flag, file, stdin or the environment variable
+type secretReader struct {
+	stdin     io.Reader
+	stdinUsed string
+}
+
+// read returns the secret from the value, the file(- for stdin) or the env in the same order, the secret is
+// registered for the redaction
+func (r *secretReader) read(name, value, file, env string) (string, error) {
+	if value != "" && file != "" {
+		return "", fmt.Errorf("only one of --%s or --%s-file can be set", name, name)
+	}
+	switch {
+	case value != "":
+	case file == "-":
+		if r.stdinUsed != "" {
+			return "", fmt.Errorf("--%s-file and --%s-file can't be both read from the stdin", r.stdinUsed, name)
+		}
+		r.stdinUsed = name
+		content, err := io.ReadAll(r.stdin)
+		if err != nil {
+			return "", fmt.Errorf("failed to read the %s from the stdin, err: %v", name, err)
+		}
+		value = strings.TrimRight(string(content), "\r\n")
+	case file != "":
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("failed to read the %s from the file, err: %v", name, err)
+		}
+		value = strings.TrimRight(string(content), "\r\n")
+	default:
+		value = os.Getenv(env)
+	}
+	utils.RegisterSecret(value)
+	return value, nil
+}
+
+// writePasswordFile writes the generated root password to the file readable only by the owner
+func writePasswordFile(file, password string) error {
+	f, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to create password file, err: %v", err)
+	}
+	defer f.Close()
+	// OpenFile doesn't change the mode of the existing file
+	if err := f.Chmod(0600); err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(f, "Root Password : %s\n", password)
+	return err
+}
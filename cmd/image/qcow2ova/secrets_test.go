@@ -0,0 +1,85 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package qcow2ova
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func Test_secretReader(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "secret")
+	if err := os.WriteFile(file, []byte("from-file\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("TEST_SECRET", "from-env")
+
+	type args struct {
+		value, file, env string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{"flag", args{value: "from-flag", env: "TEST_SECRET"}, "from-flag", false},
+		{"file", args{file: file, env: "TEST_SECRET"}, "from-file", false},
+		{"stdin", args{file: "-"}, "from-stdin", false},
+		{"env", args{env: "TEST_SECRET"}, "from-env", false},
+		{"flag and file", args{value: "from-flag", file: file}, "", true},
+		{"missing file", args{file: filepath.Join(dir, "missing")}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &secretReader{stdin: strings.NewReader("from-stdin\n")}
+			got, err := r.read("secret", tt.args.value, tt.args.file, tt.args.env)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("read() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("read() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	r := &secretReader{stdin: strings.NewReader("from-stdin")}
+	if _, err := r.read("first", "", "-", ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.read("second", "", "-", ""); err == nil {
+		t.Errorf("read() expected an error for reading the stdin twice")
+	}
+}
+
+func Test_writePasswordFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "password.txt")
+	if err := os.WriteFile(file, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := writePasswordFile(file, "some-password"); err != nil {
+		t.Fatalf("writePasswordFile() error = %v", err)
+	}
+	info, err := os.Stat(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("writePasswordFile() mode = %v, want 0600", info.Mode().Perm())
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/audit"
 	"github.com/ppc64le-cloud/pvsadm/pkg/client"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v2"
@@ -52,6 +53,7 @@ func copyWorker(copyJobs <-chan copyWorkload, results chan<- bool, workerId int)
 		start := time.Now()
 		klog.Infof("Copying object: %s src bucket: %s dest bucket: %s", copyJob.srcObject, copyJob.srcBucket, copyJob.tgtBucket)
 		err := copyJob.s3Cli.CopyObjectToBucket(copyJob.srcBucket, copyJob.tgtBucket, copyJob.srcObject)
+		audit.Record(audit.Entry{Name: "objects", Operation: "copy", ResourceID: copyJob.tgtBucket + "/" + copyJob.srcObject, Value: copyJob.srcBucket + "/" + copyJob.srcObject}, err)
 		if err != nil {
 			klog.Errorf("copy object %s failed, err: %v", copyJob.srcObject, err)
 			results <- false
@@ -205,6 +207,15 @@ func syncObjects(spec []pkg.Spec, instanceList []InstanceItem) error {
 	return nil
 }
 
+// Sync copies the objects selected in the spec from the source buckets to the target buckets
+func Sync(c *client.Client, spec []pkg.Spec) error {
+	instanceList, err := createInstanceList(spec, c)
+	if err != nil {
+		return err
+	}
+	return syncObjects(spec, instanceList)
+}
+
 var Cmd = &cobra.Command{
 	Use:   "sync",
 	Short: "Sync images between IBM COS buckets",
@@ -266,14 +277,8 @@ Sample spec.yaml file:
 			return err
 		}
 
-		// Create necessary objects
-		instanceList, err := createInstanceList(spec, pvsClient)
-		if err != nil {
-			return err
-		}
-
 		// Sync Objects
-		err = syncObjects(spec, instanceList)
+		err = Sync(pvsClient, spec)
 		if err != nil {
 			return err
 		}
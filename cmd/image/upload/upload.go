@@ -20,6 +20,7 @@ import (
 
 	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
 	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/audit"
 	"github.com/ppc64le-cloud/pvsadm/pkg/client"
 	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
 	"github.com/spf13/cobra"
@@ -34,6 +35,11 @@ const (
 	ResourceGroupAPIRegion   = "global"
 )
 
+// recordUpload records the upload of the file to the bucket in the audit log
+func recordUpload(file, bucket, object string, err error) {
+	audit.Record(audit.Entry{Name: "objects", Operation: "upload", ResourceID: bucket + "/" + object, Value: file}, err)
+}
+
 var Cmd = &cobra.Command{
 	Use:   "upload",
 	Short: "Upload the image to the IBM COS",
@@ -100,7 +106,9 @@ pvsadm image upload --bucket bucket1320 -f centos-8-latest.ova.gz --bucket-regio
 			}
 
 			// upload the Image to S3 bucket
-			return s3Cli.UploadObject(opt.ImageName, opt.ObjectName, opt.BucketName)
+			err = s3Cli.UploadObject(opt.ImageName, opt.ObjectName, opt.BucketName)
+			recordUpload(opt.ImageName, opt.BucketName, opt.ObjectName, err)
+			return err
 
 		}
 
@@ -195,8 +203,13 @@ pvsadm image upload --bucket bucket1320 -f centos-8-latest.ova.gz --bucket-regio
 
 			opt.COSInstanceName = utils.ReadUserInput("Enter the name of the Cloud Object Storage instance to be created:")
 			klog.Infof("Creating a new COS instance: %s", opt.COSInstanceName)
-			_, err = pvsClient.CreateServiceInstance(opt.COSInstanceName, utils.ServiceTypeCloudObjectStorage, utils.RetrieveValFromMap(utils.CosResourcePlanIDs, opt.ServicePlan),
+			instance, err := pvsClient.CreateServiceInstance(opt.COSInstanceName, utils.ServiceTypeCloudObjectStorage, utils.RetrieveValFromMap(utils.CosResourcePlanIDs, opt.ServicePlan),
 				opt.ResourceGrp, ResourceGroupAPIRegion)
+			entry := audit.Entry{Name: "cos", Operation: "create", Value: opt.COSInstanceName}
+			if instance != nil {
+				entry.ResourceID = ptr.Deref(instance.CRN, "")
+			}
+			audit.Record(entry, err)
 			if err != nil {
 				return err
 			}
@@ -216,6 +229,7 @@ pvsadm image upload --bucket bucket1320 -f centos-8-latest.ova.gz --bucket-regio
 			}
 
 			err = s3Cli.CreateBucket(opt.BucketName)
+			audit.Record(audit.Entry{Name: "buckets", Operation: "create", ResourceID: opt.BucketName, Value: opt.COSInstanceName + ":" + opt.Region}, err)
 			if err != nil {
 				return err
 			}
@@ -230,6 +244,7 @@ pvsadm image upload --bucket bucket1320 -f centos-8-latest.ova.gz --bucket-regio
 		}
 		//upload the Image to S3 bucket
 		err = s3Cli.UploadObject(opt.ImageName, opt.ObjectName, opt.BucketName)
+		recordUpload(opt.ImageName, opt.BucketName, opt.ObjectName, err)
 		if err != nil {
 			return err
 		}
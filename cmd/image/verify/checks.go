@@ -0,0 +1,234 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+	"k8s.io/klog/v2"
+
+	"github.com/ppc64le-cloud/pvsadm/cmd/image/qcow2ova/ova"
+	"github.com/ppc64le-cloud/pvsadm/cmd/image/qcow2ova/prep"
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+// coreosOSID is the OperatingSystemSection id set for the coreos images
+const coreosOSID = "80"
+
+// rsctPackages are the packages installed by the prep.SetupTemplate for the RMC connectivity
+var rsctPackages = []string{"rsct.core", "rsct.basic", "src", "devices.chrp.base.ServiceRM", "DynamicRM"}
+
+// checkArchive validates the OVF descriptor against the archive content, the envelope is nil if the
+// descriptor is not valid
+func checkArchive(a *ova.Archive) ([]result, *ova.Envelope) {
+	envelope, err := ova.ParseOVF(a.OVF)
+	if err != nil {
+		return []result{{"OVF descriptor", statusFail, err.Error()}}, nil
+	}
+	results := []result{{"OVF descriptor", statusPass, a.OVFName}}
+
+	if a.Meta == "" {
+		results = append(results, result{"Meta file", statusWarn, "meta file is not found in the archive"})
+	} else {
+		results = append(results, result{"Meta file", statusPass, ""})
+	}
+
+	if len(envelope.References) == 0 {
+		results = append(results, result{"File references", statusFail, "no files referenced in the OVF"})
+	}
+	for _, f := range envelope.References {
+		check := fmt.Sprintf("File %s", f.Href)
+		size, ok := a.Files[f.Href]
+		switch {
+		case !ok:
+			results = append(results, result{check, statusFail, "not found in the archive"})
+		case size != f.Size:
+			results = append(results, result{check, statusFail, fmt.Sprintf("size mismatch, ovf: %d, archive: %d", f.Size, size)})
+		default:
+			results = append(results, result{check, statusPass, utils.FormatBytes(size)})
+		}
+	}
+
+	if len(envelope.Disks) == 0 {
+		results = append(results, result{"Disks", statusFail, "no disks defined in the OVF"})
+	}
+	for _, d := range envelope.Disks {
+		check := fmt.Sprintf("Disk %s capacity", d.DiskID)
+		f, ok := envelope.File(d.FileRef)
+		if !ok {
+			results = append(results, result{check, statusFail, fmt.Sprintf("file reference %s is not found", d.FileRef)})
+			continue
+		}
+		capacity, err := d.CapacityBytes()
+		if err != nil {
+			results = append(results, result{check, statusFail, err.Error()})
+			continue
+		}
+		if capacity < f.Size {
+			results = append(results, result{check, statusFail, fmt.Sprintf("capacity %s is smaller than the file %s", utils.FormatBytes(capacity), utils.FormatBytes(f.Size))})
+			continue
+		}
+		results = append(results, result{check, statusPass, fmt.Sprintf("capacity: %s, file: %s", utils.FormatBytes(capacity), utils.FormatBytes(f.Size))})
+	}
+
+	if v := envelope.PvsadmVersion.BuildNumber; v != "" {
+		results = append(results, result{"pvsadm version", statusPass, v})
+	} else {
+		results = append(results, result{"pvsadm version", statusWarn, "image is not built with pvsadm"})
+	}
+	return results, envelope
+}
+
+func isCoreOS(envelope *ova.Envelope) bool {
+	for _, vs := range envelope.Collection.VirtualSystems {
+		if vs.OperatingSystem.ID == coreosOSID {
+			return true
+		}
+	}
+	return false
+}
+
+// checkDisk inspects the partition table, the filesystem and the content of the raw disk
+func checkDisk(raw, mnt string, coreos bool) []result {
+	var results []result
+	pt, err := prep.GetPartitionTable(raw)
+	if err != nil {
+		return append(results, result{"Partition table", statusFail, err.Error()})
+	}
+	results = append(results, result{"Partition table", statusPass, fmt.Sprintf("%s, %d partition(s)", pt.Label, len(pt.Partitions))})
+	if pt.HasPReP() {
+		results = append(results, result{"PReP boot partition", statusPass, ""})
+	} else {
+		results = append(results, result{"PReP boot partition", statusFail, "PReP boot partition is required for booting on Power"})
+	}
+
+	if coreos {
+		return append(results, result{"Image content", statusSkip, "not applicable for the coreos image"})
+	}
+	if os.Geteuid() != 0 {
+		return append(results, result{"Image content", statusSkip, "root privileges are required to mount the disk"})
+	}
+
+	fsType, cleanup, err := prep.MountImage(raw, mnt)
+	if err != nil {
+		return append(results, result{"Root filesystem", statusFail, err.Error()})
+	}
+	defer cleanup()
+	if utils.Contains([]string{"xfs", "ext2", "ext3", "ext4"}, fsType) {
+		results = append(results, result{"Root filesystem", statusPass, fsType})
+	} else {
+		results = append(results, result{"Root filesystem", statusFail, fmt.Sprintf("unsupported filesystem %s", fsType)})
+	}
+	return append(results, checkContent(mnt)...)
+}
+
+// checkContent checks the components installed by the prep.SetupTemplate in the image mounted at mnt
+func checkContent(mnt string) []result {
+	var results []result
+
+	if fileExists(filepath.Join(mnt, "usr", "bin", "cloud-init")) {
+		results = append(results, result{"cloud-init", statusPass, ""})
+	} else {
+		results = append(results, result{"cloud-init", statusFail, "cloud-init is not installed"})
+	}
+	if content, err := os.ReadFile(filepath.Join(mnt, "etc", "cloud", "cloud.cfg")); err != nil {
+		results = append(results, result{"cloud-init config", statusFail, err.Error()})
+	} else if err := yaml.Unmarshal(content, &map[string]interface{}{}); err != nil {
+		results = append(results, result{"cloud-init config", statusFail, fmt.Sprintf("invalid YAML: %v", err)})
+	} else {
+		results = append(results, result{"cloud-init config", statusPass, ""})
+	}
+
+	if fileExists(filepath.Join(mnt, "etc", "multipath.conf")) {
+		results = append(results, result{"multipath config", statusPass, ""})
+	} else {
+		results = append(results, result{"multipath config", statusFail, "/etc/multipath.conf is not found"})
+	}
+
+	if missing, err := missingPackages(mnt, rsctPackages); err != nil {
+		results = append(results, result{"RSCT packages", statusSkip, err.Error()})
+	} else if len(missing) > 0 {
+		results = append(results, result{"RSCT packages", statusFail, "missing: " + strings.Join(missing, ", ")})
+	} else {
+		results = append(results, result{"RSCT packages", statusPass, strings.Join(rsctPackages, ", ")})
+	}
+
+	return append(results, checkDracutMultipath(mnt))
+}
+
+// checkDracutMultipath checks the multipath module is included in the initramfs of all the kernels
+func checkDracutMultipath(mnt string) result {
+	const check = "dracut multipath module"
+	initramfs, err := filepath.Glob(filepath.Join(mnt, "boot", "initramfs-*.img"))
+	if err != nil || len(initramfs) == 0 {
+		return result{check, statusFail, "initramfs is not found in /boot"}
+	}
+	if _, err := exec.LookPath("lsinitrd"); err != nil {
+		conf, err := os.ReadFile(filepath.Join(mnt, "etc", "dracut.conf.d", "10-mp.conf"))
+		if err != nil || !strings.Contains(string(conf), "dm-multipath") {
+			return result{check, statusFail, "dm-multipath is not configured in /etc/dracut.conf.d"}
+		}
+		return result{check, statusWarn, "lsinitrd is not found, verified only the dracut config"}
+	}
+	var missing []string
+	for _, img := range initramfs {
+		// kdump initramfs is not used for booting
+		if strings.HasSuffix(img, "kdump.img") {
+			continue
+		}
+		out, err := exec.Command("lsinitrd", "-m", img).Output()
+		if err != nil {
+			klog.V(1).Infof("Failed to list the dracut modules of %s, err: %v", img, err)
+			missing = append(missing, filepath.Base(img))
+			continue
+		}
+		if !strings.Contains(string(out), "multipath") {
+			missing = append(missing, filepath.Base(img))
+		}
+	}
+	if len(missing) > 0 {
+		return result{check, statusFail, "missing in: " + strings.Join(missing, ", ")}
+	}
+	return result{check, statusPass, ""}
+}
+
+// missingPackages returns the packages not installed in the image using the rpm database of the image
+func missingPackages(mnt string, packages []string) ([]string, error) {
+	if _, err := exec.LookPath("rpm"); err != nil {
+		return nil, fmt.Errorf("rpm is not found on the host")
+	}
+	var missing []string
+	for _, p := range packages {
+		err := exec.Command("rpm", "--root", mnt, "-q", p).Run()
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			missing = append(missing, p)
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	return missing, nil
+}
+
+func fileExists(name string) bool {
+	_, err := os.Stat(name)
+	return err == nil
+}
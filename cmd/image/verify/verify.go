@@ -0,0 +1,125 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+
+	"github.com/ppc64le-cloud/pvsadm/cmd/image/qcow2ova/ova"
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+const (
+	statusPass = "PASS"
+	statusFail = "FAIL"
+	statusWarn = "WARN"
+	statusSkip = "SKIP"
+)
+
+// result is the outcome of a single check
+type result struct {
+	Check, Status, Details string
+}
+
+var Cmd = &cobra.Command{
+	Use:   "verify <image.ova.gz>",
+	Short: "Verify the OVA image offline",
+	Long: `Verify the OVA image offline before uploading it to the PowerVS
+pvsadm image verify --help for information
+
+The following checks are performed:
+  - OVF descriptor is valid and the referenced files are present in the archive with the matching sizes
+  - Disk capacity is not smaller than the disk file
+  - Raw disk has a partition table with the PReP boot partition and a supported root filesystem
+  - cloud-init, multipath config, RSCT packages and the dracut multipath module are installed in the image
+
+The checks inside the image need the root privileges to mount the disk, use --skip-disk-checks to run only the OVF checks.
+
+Examples:
+# Verify the image generated by the qcow2ova command
+pvsadm image verify rhel-86.ova.gz
+
+# Verify only the OVF descriptor and the archive
+pvsadm image verify rhel-86.ova.gz --skip-disk-checks
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opt := pkg.ImageCMDOptions
+		file := args[0]
+
+		klog.V(1).Infof("Reading the archive %s", file)
+		archive, err := ova.ReadArchive(file)
+		if err != nil {
+			return err
+		}
+		results, envelope := checkArchive(archive)
+
+		if opt.SkipDiskChecks {
+			results = append(results, result{"Disk checks", statusSkip, "--skip-disk-checks is set"})
+		} else if envelope != nil && !hasFailures(results) {
+			tmpDir, err := os.MkdirTemp(opt.TempDir, "image-verify")
+			if err != nil {
+				return fmt.Errorf("failed to create a temporary directory: %v", err)
+			}
+			defer os.RemoveAll(tmpDir)
+
+			f, _ := envelope.DiskFile()
+			disk := f.Href
+			raw := filepath.Join(tmpDir, filepath.Base(disk))
+			klog.Infof("Extracting the disk %s", disk)
+			if err := ova.ExtractFile(file, disk, raw); err != nil {
+				return fmt.Errorf("failed to extract the disk %s, err: %v", disk, err)
+			}
+			mnt := filepath.Join(tmpDir, "mnt")
+			if err := os.Mkdir(mnt, 0755); err != nil {
+				return err
+			}
+			results = append(results, checkDisk(raw, mnt, isCoreOS(envelope))...)
+		}
+
+		table := utils.NewTable()
+		table.SetHeader([]string{"Check", "Result", "Details"})
+		for _, r := range results {
+			table.Append([]string{r.Check, r.Status, r.Details})
+		}
+		table.Table.Render()
+
+		if hasFailures(results) {
+			return fmt.Errorf("verification failed for the image %s", file)
+		}
+		klog.Infof("Verification completed for the image %s", file)
+		return nil
+	},
+}
+
+func init() {
+	Cmd.Flags().BoolVar(&pkg.ImageCMDOptions.SkipDiskChecks, "skip-disk-checks", false, "Skip the partition table, filesystem and the image content checks")
+	Cmd.Flags().StringVarP(&pkg.ImageCMDOptions.TempDir, "temp-dir", "t", os.TempDir(), "Scratch space to use for extracting the disk")
+}
+
+func hasFailures(results []result) bool {
+	for _, r := range results {
+		if r.Status == statusFail {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,171 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify
+
+import (
+	"archive/tar"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ppc64le-cloud/pvsadm/cmd/image/qcow2ova/ova"
+	"github.com/ppc64le-cloud/pvsadm/cmd/image/qcow2ova/prep"
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+// writeTar writes the files into the tar archive
+func writeTar(t *testing.T, target string, files map[string]string) {
+	f, err := os.Create(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	tw := tar.NewWriter(f)
+	defer tw.Close()
+	for name, body := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(body))}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(body)); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func statuses(results []result) map[string]string {
+	m := map[string]string{}
+	for _, r := range results {
+		m[r.Check] = r.Status
+	}
+	return m
+}
+
+func Test_checkArchive(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "test-disk.raw"), []byte(strings.Repeat("x", 1024)), 0644); err != nil {
+		t.Fatal(err)
+	}
+	ovaFile := filepath.Join(t.TempDir(), "test.ova")
+	if err := ova.CreateTarArchive(dir, ovaFile, 1, "centos", "test-disk.raw", ova.Provenance{SourceURL: "https://example.com/image.qcow2?token=a&b"}); err != nil {
+		t.Fatal(err)
+	}
+	gzFile := ovaFile + ".gz"
+	if err := utils.GzipIt(ovaFile, gzFile); err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := ova.ReadArchive(gzFile)
+	if err != nil {
+		t.Fatalf("ReadArchive() error = %v", err)
+	}
+	results, envelope := checkArchive(a)
+	if hasFailures(results) {
+		t.Errorf("checkArchive() unexpected failures: %+v", results)
+	}
+	if f, ok := envelope.DiskFile(); !ok || f.Href != "test-disk.raw" {
+		t.Errorf("DiskFile() = %v, %v", f, ok)
+	}
+	if isCoreOS(envelope) {
+		t.Errorf("isCoreOS() = true for the centos image")
+	}
+
+	raw := filepath.Join(t.TempDir(), "disk.raw")
+	if err := ova.ExtractFile(gzFile, "test-disk.raw", raw); err != nil {
+		t.Fatalf("ExtractFile() error = %v", err)
+	}
+	if info, err := os.Stat(raw); err != nil || info.Size() != 1024 {
+		t.Errorf("ExtractFile() extracted file size mismatch, err: %v", err)
+	}
+}
+
+func Test_checkArchiveFailures(t *testing.T) {
+	ovf := `<?xml version="1.0" encoding="UTF-8"?>
+<ovf:Envelope xmlns:ovf="http://schemas.dmtf.org/ovf/envelope/1">
+  <ovf:References>
+    <ovf:File href="disk.raw" id="file1" size="2048"/>
+    <ovf:File href="missing.raw" id="file2" size="10"/>
+  </ovf:References>
+  <ovf:DiskSection>
+    <ovf:Disk capacity="1" capacityAllocationUnits="byte * 2^10" diskId="disk1" fileRef="file1"/>
+  </ovf:DiskSection>
+</ovf:Envelope>`
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  map[string]string
+	}{
+		{
+			name:  "invalid ovf",
+			files: map[string]string{"image.ovf": "<ovf:Envelope", "disk.raw": "x"},
+			want:  map[string]string{"OVF descriptor": statusFail},
+		},
+		{
+			name:  "size and capacity mismatch",
+			files: map[string]string{"image.ovf": ovf, "disk.raw": strings.Repeat("x", 1024)},
+			want: map[string]string{
+				"OVF descriptor":      statusPass,
+				"Meta file":           statusWarn,
+				"File disk.raw":       statusFail,
+				"File missing.raw":    statusFail,
+				"Disk disk1 capacity": statusFail,
+				"pvsadm version":      statusWarn,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "image.ova")
+			writeTar(t, file, tt.files)
+			a, err := ova.ReadArchive(file)
+			if err != nil {
+				t.Fatalf("ReadArchive() error = %v", err)
+			}
+			results, _ := checkArchive(a)
+			got := statuses(results)
+			for check, status := range tt.want {
+				if got[check] != status {
+					t.Errorf("checkArchive() %s = %s, want %s, results: %+v", check, got[check], status, results)
+				}
+			}
+		})
+	}
+}
+
+func TestParsePartitionTable(t *testing.T) {
+	out := `{
+   "partitiontable": {
+      "label": "dos",
+      "id": "0x2a8e4a6b",
+      "device": "disk.raw",
+      "unit": "sectors",
+      "sectorsize": 512,
+      "partitions": [
+         {"node": "disk.raw1", "start": 2048, "size": 8192, "type": "41", "bootable": true},
+         {"node": "disk.raw2", "start": 10240, "size": 20961280, "type": "83"}
+      ]
+   }
+}`
+	pt, err := prep.ParsePartitionTable([]byte(out))
+	if err != nil {
+		t.Fatalf("ParsePartitionTable() error = %v", err)
+	}
+	if pt.Label != "dos" || len(pt.Partitions) != 2 || !pt.HasPReP() {
+		t.Errorf("ParsePartitionTable() = %+v", pt)
+	}
+	if _, err := prep.ParsePartitionTable([]byte(`{}`)); err == nil {
+		t.Errorf("ParsePartitionTable() expected an error for the missing partition table")
+	}
+}
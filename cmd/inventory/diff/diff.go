@@ -0,0 +1,160 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/client"
+	"github.com/ppc64le-cloud/pvsadm/pkg/inventory"
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+var (
+	output string
+	kinds  []string
+)
+
+var Cmd = &cobra.Command{
+	Use:   "diff OLD [NEW]",
+	Short: "Show the changes between the workspace inventory snapshots",
+	Long: `Show the changes between the workspace inventory snapshots
+
+Compares the resources of the snapshots captured with the pvsadm inventory snapshot command and shows the added, removed
+and changed resources. The snapshot is compared with the live state of the workspace if only one snapshot is passed.
+
+Examples:
+# Show the changes between the two snapshots
+pvsadm inventory diff yesterday.json today.json
+
+# Show the changes since the snapshot
+pvsadm inventory diff yesterday.json --workspace-name upstream-core-lon04
+
+# Show the changed instances and volumes as JSON
+pvsadm inventory diff yesterday.json today.json --kind instance,volume -o json
+`,
+	Args: cobra.RangeArgs(1, 2),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if !utils.Contains([]string{"table", "json"}, output) {
+			return fmt.Errorf("unsupported output format %q, supported: table, json", output)
+		}
+		if len(args) == 1 {
+			return client.EnsureWorkspace(&pkg.Options.WorkspaceID, &pkg.Options.WorkspaceName)
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		from, err := inventory.Load(args[0])
+		if err != nil {
+			return err
+		}
+
+		var to *inventory.Snapshot
+		if len(args) == 2 {
+			if to, err = inventory.Load(args[1]); err != nil {
+				return err
+			}
+		} else {
+			if to, err = live(); err != nil {
+				return err
+			}
+		}
+		if from.WorkspaceID != "" && to.WorkspaceID != "" && from.WorkspaceID != to.WorkspaceID {
+			klog.Warningf("Comparing the snapshots of the different workspaces: %s and %s", from.Workspace, to.Workspace)
+		}
+
+		if skipped := slices.Concat(from.Skipped, to.Skipped); len(skipped) > 0 {
+			klog.Warningf("Not comparing the resources missing in the snapshots: %s", strings.Join(slices.Compact(slices.Sorted(slices.Values(skipped))), ", "))
+		}
+
+		changes := filterKinds(inventory.Diff(from, to), kinds)
+		if output == "json" {
+			return writeJSON(os.Stdout, changes)
+		}
+		if len(changes) == 0 {
+			klog.Infof("No changes found between %s and %s", from.Timestamp.Format(time.RFC3339), to.Timestamp.Format(time.RFC3339))
+			return nil
+		}
+		table := utils.NewTable()
+		table.SetHeader([]string{"Change", "Kind", "Name", "ID", "Details"})
+		for _, c := range changes {
+			table.Append([]string{c.Type, c.Kind, c.Name, c.ID, details(c)})
+		}
+		table.Table.Render()
+		return nil
+	},
+}
+
+func init() {
+	Cmd.Flags().StringVarP(&output, "output", "o", "table", "Output format, supported: table, json")
+	Cmd.Flags().StringSliceVar(&kinds, "kind", nil, "Show only the changes of the resource kinds, e.g: instance,volume,network,port,image,dhcp-server,ssh-key,cloud-connection")
+}
+
+// live captures the snapshot of the live state of the workspace
+func live() (*inventory.Snapshot, error) {
+	opt := pkg.Options
+	c, err := client.NewClientWithEnv(opt.APIKey, opt.Environment, opt.Debug)
+	if err != nil {
+		klog.Errorf("failed to create a session with IBM cloud, err: %v", err)
+		return nil, err
+	}
+
+	pvmclient, err := client.NewPVMClientWithEnv(c, opt.WorkspaceID, opt.WorkspaceName, opt.Environment)
+	if err != nil {
+		return nil, err
+	}
+	return inventory.Collect(pvmclient)
+}
+
+func filterKinds(changes []inventory.Change, kinds []string) []inventory.Change {
+	if len(kinds) == 0 {
+		return changes
+	}
+	var filtered []inventory.Change
+	for _, c := range changes {
+		if utils.Contains(kinds, c.Kind) {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
+// details returns the changed fields of the resource in the field: old -> new format
+func details(c inventory.Change) string {
+	var lines []string
+	for _, f := range c.Fields {
+		lines = append(lines, fmt.Sprintf("%s: %q -> %q", f.Field, f.Old, f.New))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func writeJSON(w io.Writer, changes []inventory.Change) error {
+	if changes == nil {
+		changes = []inventory.Change{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(changes)
+}
@@ -0,0 +1,37 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inventory
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ppc64le-cloud/pvsadm/cmd/inventory/diff"
+	"github.com/ppc64le-cloud/pvsadm/cmd/inventory/snapshot"
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+)
+
+var Cmd = &cobra.Command{
+	Use:     "inventory",
+	Short:   "Snapshot and compare the workspace inventory",
+	Long:    `Snapshot and compare the workspace inventory`,
+	GroupID: "resource",
+}
+
+func init() {
+	Cmd.AddCommand(diff.Cmd)
+	Cmd.AddCommand(snapshot.Cmd)
+	Cmd.PersistentFlags().StringVarP(&pkg.Options.WorkspaceID, "workspace-id", "", "", "Workspace ID or CRN of the PowerVS instance")
+	Cmd.PersistentFlags().StringVarP(&pkg.Options.WorkspaceName, "workspace-name", "", "", "Workspace name of the PowerVS")
+}
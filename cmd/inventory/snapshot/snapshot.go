@@ -0,0 +1,85 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/client"
+	"github.com/ppc64le-cloud/pvsadm/pkg/inventory"
+)
+
+var outputFile string
+
+var Cmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Capture the snapshot of the workspace inventory",
+	Long: `Capture the snapshot of the workspace inventory
+
+The snapshot contains the instances, volumes, networks, ports, images, DHCP servers, SSH keys and cloud connections of
+the workspace in the JSON format, which can be compared later with the pvsadm inventory diff command.
+
+Examples:
+# Capture the snapshot of the workspace
+pvsadm inventory snapshot --workspace-name upstream-core-lon04 > snap.json
+
+# Capture the snapshot into the file
+pvsadm inventory snapshot --workspace-name upstream-core-lon04 --output-file snap.json
+`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return client.EnsureWorkspace(&pkg.Options.WorkspaceID, &pkg.Options.WorkspaceName)
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opt := pkg.Options
+
+		c, err := client.NewClientWithEnv(opt.APIKey, opt.Environment, opt.Debug)
+		if err != nil {
+			klog.Errorf("failed to create a session with IBM cloud, err: %v", err)
+			return err
+		}
+
+		pvmclient, err := client.NewPVMClientWithEnv(c, opt.WorkspaceID, opt.WorkspaceName, opt.Environment)
+		if err != nil {
+			return err
+		}
+
+		s, err := inventory.Collect(pvmclient)
+		if err != nil {
+			return err
+		}
+
+		if outputFile == "" {
+			return s.Write(os.Stdout)
+		}
+		f, err := os.Create(outputFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if err := s.Write(f); err != nil {
+			return err
+		}
+		klog.Infof("Snapshot of the workspace %s with %d resources is written to %s", s.Workspace, len(s.Resources), outputFile)
+		return nil
+	},
+}
+
+func init() {
+	Cmd.Flags().StringVar(&outputFile, "output-file", "", "File to write the snapshot to, written to the stdout if not set")
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acquire
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	createworkspace "github.com/ppc64le-cloud/pvsadm/cmd/create/workspace"
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/audit"
+	"github.com/ppc64le-cloud/pvsadm/pkg/client"
+	"github.com/ppc64le-cloud/pvsadm/pkg/lease"
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+var (
+	ttl                                    time.Duration
+	zone, pool, owner, prefix, resourceGrp string
+	timeout                                time.Duration
+)
+
+var Cmd = &cobra.Command{
+	Use:   "acquire",
+	Short: "Lease a workspace",
+	Long: `Lease a workspace
+
+Picks a free workspace from the pool in the zone if the --pool is set, a new workspace is created otherwise or if the
+pool has no free workspaces. The ID of the leased workspace is printed to the stdout.
+
+Examples:
+# Lease a new workspace for 3 hours
+pvsadm lease acquire --ttl 3h --zone lon04
+
+# Lease a workspace from the ci pool
+pvsadm lease acquire --ttl 3h --zone lon04 --pool ci --owner "$CI_JOB_ID"
+`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if ttl <= 0 {
+			return fmt.Errorf("--ttl must be greater than 0")
+		}
+		if lease.SanitizeTag(owner) == "" {
+			return fmt.Errorf("--owner can't be empty")
+		}
+		return utils.EnsureCredentialsAreSet()
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opt := pkg.Options
+
+		c, err := client.NewClientWithEnv(opt.APIKey, opt.Environment, opt.Debug)
+		if err != nil {
+			klog.Errorf("failed to create a session with IBM cloud, err: %v", err)
+			return err
+		}
+
+		l := &lease.Lease{Owner: owner, Pool: pool, Expiry: time.Now().Add(ttl).UTC().Truncate(time.Second), Nonce: lease.NewNonce()}
+
+		var id, name string
+		if pool != "" {
+			w, err := fromPool(c, l)
+			if err != nil {
+				return err
+			}
+			if w != nil {
+				id, name = w.ID(), w.Name()
+				klog.Infof("Leased the workspace %s from the pool %s", name, pool)
+			} else {
+				klog.Infof("No free workspace in the pool %s in the %s zone, creating a new workspace", pool, zone)
+			}
+		}
+
+		if id == "" {
+			name = prefix + "-" + suffix()
+			ws, err := c.CreateServiceInstance(name, utils.ServiceTypePowerVS, utils.PowerVSResourcePlanID, resourceGrp, zone, l.Tags()...)
+			audit.Record(createworkspace.WorkspaceEntry(ws, name, zone), err)
+			if err != nil {
+				return fmt.Errorf("failed to create the workspace %s, err: %v", name, err)
+			}
+			id = ptr.Deref(ws.GUID, "")
+			klog.Infof("Waiting for the workspace %s to be active", name)
+			if err := createworkspace.WaitForActive(c, id, timeout); err != nil {
+				return err
+			}
+		}
+
+		audit.Record(audit.Entry{Name: "lease", Operation: "acquire", Workspace: id, ResourceID: id, Value: fmt.Sprintf("%s:%s:%s:%s", name, id, lease.SanitizeTag(owner), l.Expiry.Format(time.RFC3339))}, nil)
+		klog.Infof("Workspace %s is leased to %s until %s", name, lease.SanitizeTag(owner), l.Expiry.Format(time.RFC3339))
+		fmt.Println(id)
+		return nil
+	},
+}
+
+func init() {
+	defaultOwner := os.Getenv("USER")
+	if defaultOwner == "" {
+		defaultOwner = "pvsadm"
+	}
+	Cmd.Flags().DurationVar(&ttl, "ttl", 3*time.Hour, "Duration of the lease")
+	Cmd.Flags().StringVar(&zone, "zone", "", "Zone of the workspace, e.g: lon04, dal10")
+	Cmd.Flags().StringVar(&pool, "pool", "", "Pool to pick the free workspace from")
+	Cmd.Flags().StringVar(&owner, "owner", defaultOwner, "Owner of the lease, e.g: the CI job ID")
+	Cmd.Flags().StringVar(&prefix, "name-prefix", "pvsadm-lease", "Prefix of the name of the created workspace")
+	Cmd.Flags().StringVar(&resourceGrp, "resource-group", "Default", "Resource group of the created workspace")
+	Cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Minute, "Timeout for the created workspace to be active")
+	_ = Cmd.MarkFlagRequired("zone")
+}
+
+// fromPool leases a free workspace from the pool, returns nil if the pool has no free workspace in the zone
+func fromPool(c *client.Client, l *lease.Lease) (*lease.Workspace, error) {
+	workspaces, err := lease.List(c)
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range workspaces {
+		if ptr.Deref(w.Instance.RegionID, "") != zone || ptr.Deref(w.Instance.State, "") != "active" ||
+			w.Lease.Pool != lease.SanitizeTag(pool) || w.Lease.Leased() {
+			continue
+		}
+		if err := lease.Set(c, w, l); err != nil {
+			return nil, err
+		}
+		// Another acquire may have picked the same workspace concurrently, the lease is kept only if it is the single one
+		current, err := lease.Get(c, w.ID())
+		if err != nil {
+			return nil, err
+		}
+		if current.HeldBy(l) {
+			return current, nil
+		}
+		// The tags of the lease carry the nonce, detaching them leaves the tags of the other leases intact even when
+		// they share the owner and the expiry
+		klog.Warningf("Workspace %s is leased concurrently, trying the next workspace", w.Name())
+		if err := c.DetachTags(w.CRN(), lease.LeaseTags(l.Tags())...); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+func suffix() string {
+	b := make([]byte, 3)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lease
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ppc64le-cloud/pvsadm/cmd/lease/acquire"
+	"github.com/ppc64le-cloud/pvsadm/cmd/lease/reap"
+	"github.com/ppc64le-cloud/pvsadm/cmd/lease/release"
+	"github.com/ppc64le-cloud/pvsadm/cmd/lease/renew"
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "lease",
+	Short: "Lease the ephemeral workspaces",
+	Long: `Lease the ephemeral workspaces
+
+The leased workspaces are tagged with the owner and the expiry of the lease, the expired leases are released by the
+pvsadm lease reap command. The workspaces tagged with the pvsadm-lease-pool:<pool> tag are reused by the pvsadm lease
+acquire command with the --pool option and are returned to the pool instead of being deleted on the release.
+
+Examples:
+# Lease a new workspace for 3 hours, the ID of the workspace is printed to the stdout
+WORKSPACE_ID=$(pvsadm lease acquire --ttl 3h --zone lon04 --owner "$CI_JOB_ID")
+
+# Extend the lease by 1 hour from now
+pvsadm lease renew --workspace-id $WORKSPACE_ID --ttl 1h
+
+# Release the lease, the workspace is deleted
+pvsadm lease release --workspace-id $WORKSPACE_ID
+
+# Release all the expired leases
+pvsadm lease reap --no-prompt
+`,
+	GroupID: "resource",
+}
+
+func init() {
+	Cmd.AddCommand(acquire.Cmd)
+	Cmd.AddCommand(renew.Cmd)
+	Cmd.AddCommand(release.Cmd)
+	Cmd.AddCommand(reap.Cmd)
+	Cmd.PersistentFlags().StringVarP(&pkg.Options.WorkspaceID, "workspace-id", "", "", "Workspace ID or CRN of the PowerVS workspace")
+	Cmd.PersistentFlags().StringVarP(&pkg.Options.WorkspaceName, "workspace-name", "", "", "Workspace name of the PowerVS workspace")
+}
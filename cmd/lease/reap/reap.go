@@ -0,0 +1,121 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reap
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	"github.com/ppc64le-cloud/pvsadm/cmd/lease/release"
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/audit"
+	"github.com/ppc64le-cloud/pvsadm/pkg/client"
+	"github.com/ppc64le-cloud/pvsadm/pkg/lease"
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+var timeout time.Duration
+
+var Cmd = &cobra.Command{
+	Use:   "reap",
+	Short: "Release the expired leases",
+	Long: `Release the expired leases
+
+The expired leased workspaces are purged and deleted, the workspaces from the pool are purged and returned to the pool.
+
+Examples:
+# List the expired leases without releasing them
+pvsadm lease reap --dry-run
+
+# Release the expired leases without asking any confirmation
+pvsadm lease reap --no-prompt
+`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return utils.EnsureCredentialsAreSet()
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opt := pkg.Options
+
+		c, err := client.NewClientWithEnv(opt.APIKey, opt.Environment, opt.Debug)
+		if err != nil {
+			klog.Errorf("failed to create a session with IBM cloud, err: %v", err)
+			return err
+		}
+
+		workspaces, err := lease.List(c)
+		if err != nil {
+			return err
+		}
+		expired := expiredLeases(workspaces, time.Now())
+		if len(expired) == 0 {
+			klog.Info("No expired leases found")
+			return nil
+		}
+
+		t := utils.NewTable()
+		t.SetHeader([]string{"Name", "ID", "Zone", "Owner", "Expiry", "Pool"})
+		for _, w := range expired {
+			t.Append([]string{w.Name(), w.ID(), ptr.Deref(w.Instance.RegionID, ""), w.Lease.Owner, w.Lease.Expiry.Format(time.RFC3339), w.Lease.Pool})
+		}
+		t.Table.Render()
+
+		if opt.DryRun || (!opt.NoPrompt && !utils.AskConfirmation(fmt.Sprintf(utils.DeletePromptMessage, "expired leased workspaces"))) {
+			return nil
+		}
+
+		var failed int
+		for _, w := range expired {
+			entry := audit.Entry{Name: "lease", Operation: "expire", Workspace: w.ID(), ResourceID: w.ID(), Value: fmt.Sprintf("%s:%s:%s:%s", w.Name(), w.ID(), w.Lease.Owner, w.Lease.Expiry.Format(time.RFC3339))}
+			session, err := c.PISession(ptr.Deref(w.Instance.RegionID, ""))
+			if err != nil {
+				klog.Errorf("failed to create the session for the workspace %s, err: %v", w.Name(), err)
+				audit.Record(entry, err)
+				failed++
+				continue
+			}
+			err = release.Release(c, client.NewWorkspacePVMClient(&w.Instance, session), w, true, timeout)
+			audit.Record(entry, err)
+			if err != nil {
+				klog.Errorf("failed to release the workspace %s, err: %v", w.Name(), err)
+				failed++
+			}
+		}
+		if failed > 0 {
+			return fmt.Errorf("failed to release %d of %d expired leases", failed, len(expired))
+		}
+		return nil
+	},
+}
+
+func init() {
+	Cmd.Flags().BoolVar(&pkg.Options.DryRun, "dry-run", false, "List the expired leases and don't release them")
+	Cmd.Flags().BoolVar(&pkg.Options.NoPrompt, "no-prompt", false, "Show prompt before doing any destructive operations")
+	Cmd.Flags().DurationVar(&timeout, "timeout", 30*time.Minute, "Timeout for the instances to be removed while purging the workspace")
+}
+
+// expiredLeases returns the workspaces with the lease expired at the time
+func expiredLeases(workspaces []*lease.Workspace, now time.Time) []*lease.Workspace {
+	var expired []*lease.Workspace
+	for _, w := range workspaces {
+		if w.Lease != nil && w.Lease.Expired(now) {
+			expired = append(expired, w)
+		}
+	}
+	return expired
+}
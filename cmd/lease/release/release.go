@@ -0,0 +1,112 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package release
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+
+	deleteworkspace "github.com/ppc64le-cloud/pvsadm/cmd/delete/workspace"
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/audit"
+	"github.com/ppc64le-cloud/pvsadm/pkg/client"
+	"github.com/ppc64le-cloud/pvsadm/pkg/lease"
+)
+
+var (
+	purge   bool
+	timeout time.Duration
+)
+
+var Cmd = &cobra.Command{
+	Use:   "release",
+	Short: "Release the lease of the workspace",
+	Long: `Release the lease of the workspace
+
+The workspace is deleted on the release, the workspace from the pool is purged and returned to the pool instead.
+
+Examples:
+# Release the lease of the workspace
+pvsadm lease release --workspace-id 7845d372-d4e1-46b8-91fc-41051c984601
+
+# Delete the resources of the workspace before deleting the workspace
+pvsadm lease release --workspace-id 7845d372-d4e1-46b8-91fc-41051c984601 --purge
+`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return client.EnsureWorkspace(&pkg.Options.WorkspaceID, &pkg.Options.WorkspaceName)
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opt := pkg.Options
+
+		c, err := client.NewClientWithEnv(opt.APIKey, opt.Environment, opt.Debug)
+		if err != nil {
+			klog.Errorf("failed to create a session with IBM cloud, err: %v", err)
+			return err
+		}
+
+		pvmclient, err := client.NewPVMClientWithEnv(c, opt.WorkspaceID, opt.WorkspaceName, opt.Environment)
+		if err != nil {
+			return err
+		}
+
+		w, err := lease.Get(c, pvmclient.InstanceID)
+		if err != nil {
+			return err
+		}
+		if w.Lease == nil || !w.Lease.Leased() {
+			return fmt.Errorf("workspace %s is not leased", w.Name())
+		}
+		return Release(c, pvmclient, w, purge, timeout)
+	},
+}
+
+func init() {
+	Cmd.Flags().BoolVar(&purge, "purge", false, "Delete the resources of the workspace before deleting the workspace, always done for the workspace from the pool")
+	Cmd.Flags().DurationVar(&timeout, "timeout", 30*time.Minute, "Timeout for the instances to be removed while purging the workspace")
+}
+
+// Release deletes the leased workspace, the workspace from the pool is purged and returned to the pool
+func Release(c *client.Client, pvmclient *client.PVMClient, w *lease.Workspace, purge bool, timeout time.Duration) error {
+	owner := w.Lease.Owner
+	if w.Lease.Pool != "" {
+		klog.Infof("Purging the workspace %s to return it to the pool %s", w.Name(), w.Lease.Pool)
+		if err := deleteworkspace.Purge(pvmclient, timeout); err != nil {
+			return fmt.Errorf("failed to purge the workspace %s, err: %v", w.Name(), err)
+		}
+		if err := lease.Clear(c, w); err != nil {
+			return err
+		}
+		audit.Record(audit.Entry{Name: "lease", Operation: "release", Workspace: w.ID(), ResourceID: w.ID(), Value: fmt.Sprintf("%s:%s:%s:pool=%s", w.Name(), w.ID(), owner, w.Lease.Pool)}, nil)
+		klog.Infof("Workspace %s is returned to the pool %s", w.Name(), w.Lease.Pool)
+		return nil
+	}
+
+	if purge {
+		if err := deleteworkspace.Purge(pvmclient, timeout); err != nil {
+			return fmt.Errorf("failed to purge the workspace %s, err: %v", w.Name(), err)
+		}
+	}
+	klog.Infof("Deleting the workspace: %s with ID: %s", w.Name(), w.ID())
+	err := c.DeleteServiceInstance(w.ID(), true)
+	audit.Record(audit.Entry{Name: "workspace", Operation: "delete", Workspace: w.ID(), ResourceID: w.ID(), Value: w.Name() + ":" + pvmclient.Zone}, err)
+	if err != nil {
+		return fmt.Errorf("failed to delete the workspace %s, err: %v", w.Name(), err)
+	}
+	audit.Record(audit.Entry{Name: "lease", Operation: "release", Workspace: w.ID(), ResourceID: w.ID(), Value: fmt.Sprintf("%s:%s:%s", w.Name(), w.ID(), owner)}, nil)
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package renew
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/audit"
+	"github.com/ppc64le-cloud/pvsadm/pkg/client"
+	"github.com/ppc64le-cloud/pvsadm/pkg/lease"
+)
+
+var ttl time.Duration
+
+var Cmd = &cobra.Command{
+	Use:   "renew",
+	Short: "Renew the lease of the workspace",
+	Long: `Renew the lease of the workspace, the lease expires after the --ttl from now
+
+Examples:
+# Extend the lease by 1 hour from now
+pvsadm lease renew --workspace-id 7845d372-d4e1-46b8-91fc-41051c984601 --ttl 1h
+`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if ttl <= 0 {
+			return fmt.Errorf("--ttl must be greater than 0")
+		}
+		return client.EnsureWorkspace(&pkg.Options.WorkspaceID, &pkg.Options.WorkspaceName)
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opt := pkg.Options
+
+		c, err := client.NewClientWithEnv(opt.APIKey, opt.Environment, opt.Debug)
+		if err != nil {
+			klog.Errorf("failed to create a session with IBM cloud, err: %v", err)
+			return err
+		}
+
+		pvmclient, err := client.NewPVMClientWithEnv(c, opt.WorkspaceID, opt.WorkspaceName, opt.Environment)
+		if err != nil {
+			return err
+		}
+
+		w, err := lease.Get(c, pvmclient.InstanceID)
+		if err != nil {
+			return err
+		}
+		if w.Lease == nil || !w.Lease.Leased() {
+			return fmt.Errorf("workspace %s is not leased", w.Name())
+		}
+
+		l := &lease.Lease{Owner: w.Lease.Owner, Pool: w.Lease.Pool, Expiry: time.Now().Add(ttl).UTC().Truncate(time.Second), Nonce: w.Lease.Nonce}
+		if err := lease.Set(c, w, l); err != nil {
+			return err
+		}
+		audit.Record(audit.Entry{Name: "lease", Operation: "renew", Workspace: w.ID(), ResourceID: w.ID(), Value: fmt.Sprintf("%s:%s:%s:%s", w.Name(), w.ID(), l.Owner, l.Expiry.Format(time.RFC3339))}, nil)
+		klog.Infof("Lease of the workspace %s is renewed until %s", w.Name(), l.Expiry.Format(time.RFC3339))
+		return nil
+	},
+}
+
+func init() {
+	Cmd.Flags().DurationVar(&ttl, "ttl", 3*time.Hour, "Duration of the lease from now")
+}
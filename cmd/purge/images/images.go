@@ -64,6 +64,7 @@ pvsadm purge --help for information
 				for _, image := range images {
 					klog.Infof("Deleting image: %s with ID: %s", *image.Name, *image.ImageID)
 					err = pvmclient.ImgClient.Delete(*image.ImageID)
+					audit.Record(audit.Entry{Name: "images", Operation: "delete", Workspace: pvmclient.InstanceID, ResourceID: *image.ImageID, Value: pvmclient.InstanceName + ":" + *image.Name}, err)
 					if err != nil {
 						if opt.IgnoreErrors {
 							klog.Errorf("error occurred while deleting the image: %v", err)
@@ -71,7 +72,6 @@ pvsadm purge --help for information
 							return err
 						}
 					}
-					audit.Log("images", "delete", pvmclient.InstanceName+":"+*image.Name)
 				}
 			}
 		}
@@ -99,6 +99,7 @@ pvsadm purge --help for information
 					}
 					klog.Infof("Deleting network: %s with ID: %s", *network.Name, *network.NetworkID)
 					err = pvmclient.NetworkClient.Delete(*network.NetworkID)
+					audit.Record(audit.Entry{Name: "networks", Operation: "delete", Workspace: pvmclient.InstanceID, ResourceID: *network.NetworkID, Value: pvmclient.InstanceName + ":" + *network.Name}, err)
 					if err != nil {
 						if opt.IgnoreErrors {
 							klog.Errorf("error occurred while deleting the network: %v", err)
@@ -106,7 +107,6 @@ pvsadm purge --help for information
 							return err
 						}
 					}
-					audit.Log("networks", "delete", pvmclient.InstanceName+":"+*network.Name)
 				}
 			}
 		}
@@ -83,6 +83,7 @@ pvsadm purge --help for information
 				for _, instance := range instances {
 					klog.Infof("Deleting instance: %s with ID: %s", *instance.ServerName, *instance.PvmInstanceID)
 					err = pvmclient.InstanceClient.Delete(*instance.PvmInstanceID)
+					audit.Record(audit.Entry{Name: "vms", Operation: "delete", Workspace: pvmclient.InstanceID, ResourceID: *instance.PvmInstanceID, Value: pvmclient.InstanceName + ":" + *instance.ServerName}, err)
 					if err != nil {
 						if opt.IgnoreErrors {
 							klog.Errorf("error occurred while deleting the vm: %v", err)
@@ -90,7 +91,6 @@ pvsadm purge --help for information
 							return err
 						}
 					}
-					audit.Log("vms", "delete", pvmclient.InstanceName+":"+*instance.ServerName)
 				}
 			}
 		}
@@ -69,6 +69,7 @@ pvsadm purge --help for information
 					if *volume.State == "available" {
 						klog.Infof("Deleting volume: %s with ID: %s", *volume.Name, *volume.VolumeID)
 						err = pvmclient.VolumeClient.DeleteVolume(*volume.VolumeID)
+						audit.Record(audit.Entry{Name: "volumes", Operation: "delete", Workspace: pvmclient.InstanceID, ResourceID: *volume.VolumeID, Value: pvmclient.InstanceName + ":" + *volume.Name}, err)
 						if err != nil {
 							if opt.IgnoreErrors {
 								klog.Errorf("error occurred while deleting the volume: %v", err)
@@ -76,7 +77,6 @@ pvsadm purge --help for information
 								return err
 							}
 						}
-						audit.Log("volumes", "delete", pvmclient.InstanceName+":"+*volume.Name)
 					}
 				}
 			}
@@ -15,6 +15,7 @@
 package cmd
 
 import (
+	"errors"
 	goflag "flag"
 	"fmt"
 	"os"
@@ -25,17 +26,24 @@ import (
 	flag "github.com/spf13/pflag"
 	"k8s.io/klog/v2"
 
+	auditcmd "github.com/ppc64le-cloud/pvsadm/cmd/audit"
+	configcmd "github.com/ppc64le-cloud/pvsadm/cmd/config"
 	"github.com/ppc64le-cloud/pvsadm/cmd/create"
 	deletecmd "github.com/ppc64le-cloud/pvsadm/cmd/delete"
+	"github.com/ppc64le-cloud/pvsadm/cmd/describe"
 	"github.com/ppc64le-cloud/pvsadm/cmd/dhcp-sync"
 	"github.com/ppc64le-cloud/pvsadm/cmd/dhcpserver"
 	"github.com/ppc64le-cloud/pvsadm/cmd/get"
 	"github.com/ppc64le-cloud/pvsadm/cmd/image"
+	"github.com/ppc64le-cloud/pvsadm/cmd/inventory"
+	"github.com/ppc64le-cloud/pvsadm/cmd/lease"
 	"github.com/ppc64le-cloud/pvsadm/cmd/purge"
+	"github.com/ppc64le-cloud/pvsadm/cmd/serve"
 	versioncmd "github.com/ppc64le-cloud/pvsadm/cmd/version"
 	"github.com/ppc64le-cloud/pvsadm/pkg"
 	"github.com/ppc64le-cloud/pvsadm/pkg/audit"
 	"github.com/ppc64le-cloud/pvsadm/pkg/client"
+	"github.com/ppc64le-cloud/pvsadm/pkg/config"
 	"github.com/ppc64le-cloud/pvsadm/pkg/version"
 )
 
@@ -48,6 +56,9 @@ Integrated with the IBM Cloud platform for on-demand provisioning.
 
 This is a tool built for the Power Systems Virtual Server helps managing and maintaining the resources easily`,
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		audit.Logger = auditSink()
+		audit.SetCommand(cmd.CommandPath())
+
 		if pkg.Options.APIKey == "" {
 			// The GetAuthenticatorFromEnvironment requires "IBMCLOUD_APIKEY" to be set.
 			// Ref: github.com/ibm/go-sdk-core/v5@v5.17.2/core/config_utils.go, which is available from either from a credentials file, environment or VCAP service.
@@ -66,13 +77,31 @@ This is a tool built for the Power Systems Virtual Server helps managing and mai
 			os.Setenv("IBMCLOUD_APIKEY", pkg.Options.APIKey)
 		}
 
-		if _, err := client.GetEnvironment(pkg.Options.Environment); err != nil {
+		if _, err := client.GetEnvironment(pkg.Options.Environment); errors.Is(err, client.ErrEnvironmentNotFound) {
 			return fmt.Errorf("invalid \"%s\" IBM Cloud Environment passed, valid values are: %s", pkg.Options.Environment, strings.Join(client.ListEnvironments(), ", "))
+		} else if err != nil {
+			// The commands talking to the IBM Cloud fail on getting the environment again
+			klog.Warning(err)
 		}
 		return nil
 	},
 }
 
+// auditSink returns the audit sinks set in the config file, falls back to the --audit-file when the config is invalid to
+// not fail the commands fixing the config or not auditing anything, e.g: pvsadm config and pvsadm version
+func auditSink() audit.Sink {
+	cfg, err := config.Load()
+	if err == nil {
+		var sink audit.Sink
+		if sink, err = audit.NewSink(cfg.Audit.Sinks, pkg.Options.AuditFile, pkg.Options.AuditMaxSize); err == nil {
+			return sink
+		}
+	}
+	klog.Warningf("Writing the audit entries to the file %s, failed to set up the audit sinks of the config, err: %v", pkg.Options.AuditFile, err)
+	sink, _ := audit.NewSink(nil, pkg.Options.AuditFile, pkg.Options.AuditMaxSize)
+	return sink
+}
+
 func init() {
 	// Initilize the klog flags
 	klog.InitFlags(nil)
@@ -94,30 +123,38 @@ func init() {
 	rootCmd.AddCommand(deletecmd.Cmd)
 	rootCmd.AddCommand(dhcp.Cmd)
 	rootCmd.AddCommand(dhcpserver.Cmd)
+	rootCmd.AddCommand(serve.Cmd)
+	rootCmd.AddCommand(inventory.Cmd)
+	rootCmd.AddCommand(describe.Cmd)
+	rootCmd.AddCommand(lease.Cmd)
+	rootCmd.AddCommand(configcmd.Cmd)
+	rootCmd.AddCommand(auditcmd.Cmd)
 	rootCmd.PersistentFlags().StringVarP(&pkg.Options.APIKey, "api-key", "k", "", "IBMCLOUD API Key(env name: IBMCLOUD_APIKEY)")
-	rootCmd.PersistentFlags().StringVar(&pkg.Options.Environment, "env", client.DefaultEnvProd, "IBM Cloud Environments, supported are: ["+strings.Join(client.ListEnvironments(), ", ")+"]")
+	rootCmd.PersistentFlags().StringVar(&pkg.Options.IAMToken, "iam-token", "", "IAM bearer token used instead of the API key, e.g: obtained with ibmcloud iam oauth-tokens")
+	rootCmd.PersistentFlags().StringVar(&pkg.Options.TrustedProfile, "trusted-profile", "", "Name or ID of the IAM trusted profile assumed with the compute resource token, e.g: in the Kubernetes pods")
+	rootCmd.PersistentFlags().StringVar(&pkg.Options.CRTokenFile, "cr-token-file", "", "Compute resource token file used with the --trusted-profile (default: the token mounted in the pod, e.g: /var/run/secrets/tokens/sa-token)")
+	rootCmd.PersistentFlags().BoolVar(&pkg.Options.TokenCache, "token-cache", false, "Cache the IAM token on disk(~/.cache/pvsadm/tokens) and reuse it across the invocations until it expires")
+	rootCmd.PersistentFlags().StringVar(&pkg.Options.AccountID, "account-id", "", "ID of the IBM Cloud account to work against, overrides the account selected with: pvsadm config account")
+	rootCmd.PersistentFlags().StringVar(&pkg.Options.Environment, "env", client.DefaultEnvProd, "IBM Cloud Environments, supported are: [prod, test] and the ones defined in the config file, list them with: pvsadm config environments")
+	rootCmd.PersistentFlags().BoolVar(&pkg.Options.PrivateEndpoints, "private-endpoints", false, "Use the private endpoints of the IBM Cloud services, e.g: when running in the IBM Cloud VPC")
 	rootCmd.PersistentFlags().BoolVar(&pkg.Options.Debug, "debug", false, "Enable PowerVS debug option(ATTENTION: dev only option, may print sensitive data from APIs)")
-	rootCmd.PersistentFlags().StringVar(&pkg.Options.AuditFile, "audit-file", "pvsadm_audit.log", "Audit logs for the tool")
+	rootCmd.PersistentFlags().StringVar(&pkg.Options.AuditFile, "audit-file", "pvsadm_audit.log", "Audit log of the operations modifying the resources, query it with: pvsadm audit query, the syslog and webhook sinks are set in the config file")
+	rootCmd.PersistentFlags().IntVar(&pkg.Options.AuditMaxSize, "audit-max-size", 10, "Size in MiB after which the audit file is rotated, 0 disables the rotation")
+	rootCmd.PersistentFlags().StringVar(&pkg.Options.Zone, "zone", "", "Zone of the workspace, picks the workspace among the ones sharing the --workspace-name")
+	rootCmd.PersistentFlags().StringVar(&pkg.Options.ConfigFile, "config", "", "Config file of the tool (default ~/.config/pvsadm/config.yaml)")
 	rootCmd.Flags().SortFlags = false
 	rootCmd.PersistentFlags().SortFlags = false
 	_ = rootCmd.Flags().MarkHidden("debug")
-
-	// Hide the --audit-file for the image subcommand
-	// TODO: Remove this after adding audit support to image subcommand
-	origHelpFunc := rootCmd.HelpFunc()
-	rootCmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
-		if cmd.Name() == "image" || (cmd.Parent() != nil && cmd.Parent().Name() == "image") {
-			cmd.Flags().MarkHidden("audit-file")
-		}
-		origHelpFunc(cmd, args)
-	})
-
-	audit.Logger = audit.New(pkg.Options.AuditFile)
-
 }
 
 func Execute() error {
-	defer audit.Delete(pkg.Options.AuditFile)
+	defer func() {
+		if audit.Logger != nil {
+			if err := audit.Logger.Close(); err != nil {
+				klog.Errorf("failed to close the audit log, err: %v", err)
+			}
+		}
+	}()
 	if err := rootCmd.Execute(); err != nil {
 		return err
 	}
@@ -0,0 +1,231 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"k8s.io/utils/ptr"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg/client"
+	promfmt "github.com/ppc64le-cloud/pvsadm/pkg/metrics"
+)
+
+// Names of the collectors reported with the pvsadm_collector_success metric
+const (
+	collectorInstances    = "instances"
+	collectorVolumes      = "volumes"
+	collectorNetworks     = "networks"
+	collectorPorts        = "ports"
+	collectorImages       = "images"
+	collectorDHCPServers  = "dhcp_servers"
+	collectorStorageTiers = "storage_tiers"
+)
+
+var collectors = []string{collectorInstances, collectorVolumes, collectorNetworks, collectorPorts, collectorImages,
+	collectorDHCPServers, collectorStorageTiers}
+
+// inventory is the snapshot of the workspace resources
+type inventory struct {
+	instances    []*models.PVMInstanceReference
+	volumes      []*models.VolumeReference
+	networks     []*models.Network
+	ports        map[string][]*models.NetworkPort
+	images       []*models.ImageReference
+	dhcpServers  models.DHCPServers
+	storageTiers models.RegionStorageTiers
+}
+
+// fetch collects the inventory of the workspace, returns the errors keyed by the collector name
+func fetch(pvmclient *client.PVMClient) (*inventory, map[string]error) {
+	inv := &inventory{ports: map[string][]*models.NetworkPort{}}
+	errs := map[string]error{}
+
+	if instances, err := pvmclient.InstanceClient.GetAll(); err != nil {
+		errs[collectorInstances] = err
+	} else {
+		inv.instances = instances.PvmInstances
+	}
+
+	if volumes, err := pvmclient.VolumeClient.GetAll(); err != nil {
+		errs[collectorVolumes] = err
+	} else {
+		inv.volumes = volumes.Volumes
+	}
+
+	if networks, err := pvmclient.NetworkClient.GetAll(); err != nil {
+		errs[collectorNetworks] = err
+		errs[collectorPorts] = err
+	} else {
+		for _, n := range networks.Networks {
+			id := ptr.Deref(n.NetworkID, "")
+			// The network list doesn't carry the IP address metrics
+			network, err := pvmclient.NetworkClient.Get(id)
+			if err != nil {
+				errs[collectorNetworks] = err
+			} else {
+				inv.networks = append(inv.networks, network)
+			}
+			ports, err := pvmclient.NetworkClient.GetAllPorts(id)
+			if err != nil {
+				errs[collectorPorts] = err
+			} else {
+				inv.ports[id] = ports.Ports
+			}
+		}
+	}
+
+	if images, err := pvmclient.ImgClient.GetAll(); err != nil {
+		errs[collectorImages] = err
+	} else {
+		inv.images = images.Images
+	}
+
+	if servers, err := pvmclient.DHCPClient.GetAll(); err != nil {
+		errs[collectorDHCPServers] = err
+	} else {
+		inv.dhcpServers = servers
+	}
+
+	if tiers, err := pvmclient.StorageTierClient.GetAll(); err != nil {
+		errs[collectorStorageTiers] = err
+	} else {
+		inv.storageTiers = tiers
+	}
+	return inv, errs
+}
+
+// build returns the metric families of the inventory, the metrics of the failed collectors are left out
+func build(workspace string, inv *inventory, errs map[string]error) []*promfmt.Family {
+	labels := promfmt.Labels{"workspace": workspace}
+	var families []*promfmt.Family
+
+	success := promfmt.NewGauge("pvsadm_collector_success", "Whether the last collection of the resources succeeded")
+	for _, c := range collectors {
+		v := 1.0
+		if errs[c] != nil {
+			v = 0
+		}
+		success.Add(v, promfmt.Labels{"workspace": workspace, "collector": c})
+	}
+	families = append(families, success)
+
+	if errs[collectorInstances] == nil {
+		counts := map[string]int{}
+		for _, i := range inv.instances {
+			counts[ptr.Deref(i.Status, "")]++
+		}
+		f := promfmt.NewGauge("pvsadm_instances", "Number of the instances by the status")
+		f.Counts(counts, "status", labels)
+		families = append(families, f)
+	}
+
+	if errs[collectorVolumes] == nil {
+		counts, sizes := map[string]int{}, map[string]float64{}
+		unattached := 0
+		for _, v := range inv.volumes {
+			state := ptr.Deref(v.State, "")
+			counts[state]++
+			sizes[state] += ptr.Deref(v.Size, 0)
+			if len(v.PvmInstanceIDs) == 0 {
+				unattached++
+			}
+		}
+		f := promfmt.NewGauge("pvsadm_volumes", "Number of the volumes by the state")
+		f.Counts(counts, "state", labels)
+		size := promfmt.NewGauge("pvsadm_volumes_size_gigabytes", "Total size of the volumes by the state")
+		for state, s := range sizes {
+			size.Add(s, promfmt.Labels{"workspace": workspace, "state": state})
+		}
+		u := promfmt.NewGauge("pvsadm_volumes_unattached", "Number of the volumes not attached to any instance")
+		u.Add(float64(unattached), labels)
+		families = append(families, f, size, u)
+	}
+
+	if errs[collectorNetworks] == nil {
+		counts := map[string]int{}
+		ips := promfmt.NewGauge("pvsadm_network_ip_addresses", "Number of the IP addresses of the network by the kind(available, used, total)")
+		utilization := promfmt.NewGauge("pvsadm_network_ip_utilization_ratio", "Ratio of the used IP addresses of the network")
+		for _, n := range inv.networks {
+			counts[ptr.Deref(n.Type, "")]++
+			m := n.IPAddressMetrics
+			if m == nil {
+				continue
+			}
+			nl := func(kind string) promfmt.Labels {
+				l := promfmt.Labels{"workspace": workspace, "network": ptr.Deref(n.Name, ""), "network_id": ptr.Deref(n.NetworkID, "")}
+				if kind != "" {
+					l["kind"] = kind
+				}
+				return l
+			}
+			ips.Add(ptr.Deref(m.Available, 0), nl("available"))
+			ips.Add(ptr.Deref(m.Used, 0), nl("used"))
+			ips.Add(ptr.Deref(m.Total, 0), nl("total"))
+			utilization.Add(ptr.Deref(m.Utilization, 0)/100, nl(""))
+		}
+		f := promfmt.NewGauge("pvsadm_networks", "Number of the networks by the type")
+		f.Counts(counts, "type", labels)
+		families = append(families, f, ips, utilization)
+	}
+
+	if errs[collectorPorts] == nil {
+		names := map[string]string{}
+		for _, n := range inv.networks {
+			names[ptr.Deref(n.NetworkID, "")] = ptr.Deref(n.Name, "")
+		}
+		f := promfmt.NewGauge("pvsadm_network_ports", "Number of the ports of the network by the status")
+		for id, ports := range inv.ports {
+			counts := map[string]int{}
+			for _, p := range ports {
+				counts[ptr.Deref(p.Status, "")]++
+			}
+			f.Counts(counts, "status", promfmt.Labels{"workspace": workspace, "network": names[id], "network_id": id})
+		}
+		families = append(families, f)
+	}
+
+	if errs[collectorImages] == nil {
+		counts := map[string]int{}
+		for _, i := range inv.images {
+			counts[ptr.Deref(i.State, "")]++
+		}
+		f := promfmt.NewGauge("pvsadm_images", "Number of the images by the state")
+		f.Counts(counts, "state", labels)
+		families = append(families, f)
+	}
+
+	if errs[collectorDHCPServers] == nil {
+		counts := map[string]int{}
+		for _, s := range inv.dhcpServers {
+			counts[ptr.Deref(s.Status, "")]++
+		}
+		f := promfmt.NewGauge("pvsadm_dhcp_servers", "Number of the DHCP servers by the status")
+		f.Counts(counts, "status", labels)
+		families = append(families, f)
+	}
+
+	if errs[collectorStorageTiers] == nil {
+		f := promfmt.NewGauge("pvsadm_storage_tier_available", "Whether the storage tier is active in the workspace")
+		for _, t := range inv.storageTiers {
+			v := 0.0
+			if ptr.Deref(t.State, "") == "active" {
+				v = 1
+			}
+			f.Add(v, promfmt.Labels{"workspace": workspace, "tier": t.Name})
+		}
+		families = append(families, f)
+	}
+	return families
+}
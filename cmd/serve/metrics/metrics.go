@@ -0,0 +1,169 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/client"
+	promfmt "github.com/ppc64le-cloud/pvsadm/pkg/metrics"
+)
+
+var (
+	listenAddress string
+	interval      time.Duration
+)
+
+var Cmd = &cobra.Command{
+	Use:   "metrics",
+	Short: "Serve the workspace inventory as Prometheus metrics",
+	Long: `Serve the workspace inventory as Prometheus metrics
+
+Periodically collects the counts and the states of the instances, volumes, networks, ports, images, DHCP servers and the
+storage tier availability of the workspace and exposes them on the /metrics endpoint. The last successful collection
+is served between the intervals, the metrics of a failed collector are left out and reported with the
+pvsadm_collector_success metric.
+
+Examples:
+# Serve the metrics of the workspace on the default port
+pvsadm serve metrics --workspace-name upstream-core-lon04
+
+# Collect every 10 minutes and listen on the localhost only
+pvsadm serve metrics --workspace-name upstream-core-lon04 --interval 10m --listen-address 127.0.0.1:9742
+`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if interval <= 0 {
+			return fmt.Errorf("--interval must be greater than 0")
+		}
+		return client.EnsureWorkspace(&pkg.Options.WorkspaceID, &pkg.Options.WorkspaceName)
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opt := pkg.Options
+
+		c, err := client.NewClientWithEnv(opt.APIKey, opt.Environment, opt.Debug)
+		if err != nil {
+			klog.Errorf("failed to create a session with IBM cloud, err: %v", err)
+			return err
+		}
+
+		pvmclient, err := client.NewPVMClientWithEnv(c, opt.WorkspaceID, opt.WorkspaceName, opt.Environment)
+		if err != nil {
+			return err
+		}
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+		defer stop()
+
+		e := &exporter{workspace: pvmclient.InstanceName, collect: func() (*inventory, map[string]error) {
+			return fetch(pvmclient)
+		}}
+		go e.run(ctx, interval)
+
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", e)
+		server := &http.Server{Addr: listenAddress, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+		go func() {
+			<-ctx.Done()
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			server.Shutdown(shutdownCtx)
+		}()
+
+		klog.Infof("Serving the metrics of the workspace %s on %s/metrics", pvmclient.InstanceName, listenAddress)
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			return fmt.Errorf("failed to serve the metrics, err: %v", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	Cmd.Flags().StringVar(&listenAddress, "listen-address", ":9742", "Address to listen on for the /metrics endpoint")
+	Cmd.Flags().DurationVar(&interval, "interval", 5*time.Minute, "Interval between the collections of the workspace inventory")
+}
+
+// exporter collects the inventory periodically and serves the metrics of the last collection
+type exporter struct {
+	workspace string
+	collect   func() (*inventory, map[string]error)
+
+	mu   sync.RWMutex
+	body []byte
+}
+
+func (e *exporter) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		e.update()
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// update runs the collection and renders the metrics
+func (e *exporter) update() {
+	start := time.Now()
+	inv, errs := e.collect()
+	for name, err := range errs {
+		klog.Errorf("failed to collect the %s, err: %v", name, err)
+	}
+
+	families := build(e.workspace, inv, errs)
+	families = append(families, collectionFamilies(e.workspace, start, time.Since(start))...)
+	var b bytes.Buffer
+	if err := promfmt.Write(&b, families); err != nil {
+		klog.Errorf("failed to render the metrics, err: %v", err)
+		return
+	}
+	e.mu.Lock()
+	e.body = b.Bytes()
+	e.mu.Unlock()
+}
+
+func (e *exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e.mu.RLock()
+	body := e.body
+	e.mu.RUnlock()
+	if body == nil {
+		http.Error(w, "metrics are not collected yet", http.StatusServiceUnavailable)
+		return
+	}
+	w.Header().Set("Content-Type", promfmt.ContentType)
+	w.Write(body)
+}
+
+func collectionFamilies(workspace string, start time.Time, duration time.Duration) []*promfmt.Family {
+	labels := promfmt.Labels{"workspace": workspace}
+	last := promfmt.NewGauge("pvsadm_last_collection_timestamp_seconds", "Unix time of the last collection of the workspace inventory")
+	last.Add(float64(start.Unix()), labels)
+	took := promfmt.NewGauge("pvsadm_collection_duration_seconds", "Duration of the last collection of the workspace inventory")
+	took.Add(duration.Seconds(), labels)
+	return []*promfmt.Family{last, took}
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"k8s.io/utils/ptr"
+
+	promfmt "github.com/ppc64le-cloud/pvsadm/pkg/metrics"
+)
+
+func testInventory() *inventory {
+	return &inventory{
+		instances: []*models.PVMInstanceReference{
+			{Status: ptr.To("ACTIVE")}, {Status: ptr.To("ACTIVE")}, {Status: ptr.To("ERROR")},
+		},
+		volumes: []*models.VolumeReference{
+			{State: ptr.To("in-use"), Size: ptr.To(20.0), PvmInstanceIDs: []string{"vm-1"}},
+			{State: ptr.To("available"), Size: ptr.To(100.0)},
+		},
+		networks: []*models.Network{
+			{Name: ptr.To("pub"), NetworkID: ptr.To("net-1"), Type: ptr.To("pub-vlan"), IPAddressMetrics: &models.NetworkIPAddressMetrics{
+				Available: ptr.To(2.0), Used: ptr.To(2.0), Total: ptr.To(4.0), Utilization: ptr.To(50.0),
+			}},
+		},
+		ports:        map[string][]*models.NetworkPort{"net-1": {{Status: ptr.To("ACTIVE")}, {Status: ptr.To("DOWN")}}},
+		images:       []*models.ImageReference{{State: ptr.To("active")}},
+		dhcpServers:  models.DHCPServers{{Status: ptr.To("ACTIVE")}},
+		storageTiers: models.RegionStorageTiers{{Name: "tier1", State: ptr.To("active")}, {Name: "tier5k", State: ptr.To("inactive")}},
+	}
+}
+
+func render(t *testing.T, families []*promfmt.Family) string {
+	var b bytes.Buffer
+	if err := promfmt.Write(&b, families); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	return b.String()
+}
+
+func Test_build(t *testing.T) {
+	tests := []struct {
+		name    string
+		errs    map[string]error
+		want    []string
+		notwant []string
+	}{
+		{
+			name: "all collectors succeed",
+			want: []string{
+				`pvsadm_collector_success{collector="images",workspace="ws"} 1`,
+				`pvsadm_instances{status="ACTIVE",workspace="ws"} 2`,
+				`pvsadm_instances{status="ERROR",workspace="ws"} 1`,
+				`pvsadm_volumes{state="available",workspace="ws"} 1`,
+				`pvsadm_volumes_size_gigabytes{state="available",workspace="ws"} 100`,
+				`pvsadm_volumes_unattached{workspace="ws"} 1`,
+				`pvsadm_networks{type="pub-vlan",workspace="ws"} 1`,
+				`pvsadm_network_ip_addresses{kind="available",network="pub",network_id="net-1",workspace="ws"} 2`,
+				`pvsadm_network_ip_utilization_ratio{network="pub",network_id="net-1",workspace="ws"} 0.5`,
+				`pvsadm_network_ports{network="pub",network_id="net-1",status="DOWN",workspace="ws"} 1`,
+				`pvsadm_images{state="active",workspace="ws"} 1`,
+				`pvsadm_dhcp_servers{status="ACTIVE",workspace="ws"} 1`,
+				`pvsadm_storage_tier_available{tier="tier1",workspace="ws"} 1`,
+				`pvsadm_storage_tier_available{tier="tier5k",workspace="ws"} 0`,
+			},
+		},
+		{
+			name: "failed collector is left out",
+			errs: map[string]error{collectorVolumes: fmt.Errorf("timeout")},
+			want: []string{
+				`pvsadm_collector_success{collector="volumes",workspace="ws"} 0`,
+				`pvsadm_instances{status="ACTIVE",workspace="ws"} 2`,
+			},
+			notwant: []string{"pvsadm_volumes"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := render(t, build("ws", testInventory(), tt.errs))
+			for _, w := range tt.want {
+				if !strings.Contains(got, w+"\n") {
+					t.Errorf("build() %s does not contain the %s", got, w)
+				}
+			}
+			for _, w := range tt.notwant {
+				if strings.Contains(got, w) {
+					t.Errorf("build() %s contain the %s", got, w)
+				}
+			}
+		})
+	}
+}
+
+func Test_exporter(t *testing.T) {
+	e := &exporter{workspace: "ws", collect: func() (*inventory, map[string]error) {
+		return testInventory(), map[string]error{}
+	}}
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("status before the collection = %d, want %d", resp.StatusCode, http.StatusServiceUnavailable)
+	}
+
+	e.update()
+	resp, err = http.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var b bytes.Buffer
+	b.ReadFrom(resp.Body)
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != promfmt.ContentType {
+		t.Errorf("unexpected response, status: %d, content type: %s", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+	for _, w := range []string{"pvsadm_last_collection_timestamp_seconds{workspace=\"ws\"}", "pvsadm_instances{status=\"ACTIVE\",workspace=\"ws\"} 2"} {
+		if !strings.Contains(b.String(), w) {
+			t.Errorf("response %s does not contain the %s", b.String(), w)
+		}
+	}
+}
@@ -24,6 +24,7 @@ require (
 	github.com/vbauerster/mpb/v8 v8.12.1
 	go.uber.org/mock v0.6.0
 	gopkg.in/yaml.v2 v2.4.0
+	gopkg.in/yaml.v3 v3.0.1
 	k8s.io/klog/v2 v2.140.0
 	k8s.io/utils v0.0.0-20230726121419-3b25d923346b
 )
@@ -107,6 +108,5 @@ require (
 	golang.org/x/term v0.43.0 // indirect
 	golang.org/x/text v0.37.0 // indirect
 	gopkg.in/tomb.v1 v1.0.0-20141024135613-dd632973f1e7 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 	sigs.k8s.io/yaml v1.6.0 // indirect
 )
@@ -15,70 +15,82 @@
 package audit
 
 import (
-	"encoding/json"
-	"os"
 	"sync"
 	"time"
 
-	"github.com/ppc64le-cloud/pvsadm/pkg"
 	"k8s.io/klog/v2"
 )
 
-var Logger *Audit
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+)
 
-func Log(name, op, value string) {
-	Logger.Log(name, op, value)
+// Logger is the sink the entries are recorded to
+var Logger Sink
+
+// Entry is the record of an operation performed by the tool
+type Entry struct {
+	Timestamp time.Time `json:"timestamp"`
+	Name      string    `json:"name"`
+	Operation string    `json:"op"`
+	Value     string    `json:"value,omitempty"`
+	// ResourceID is the ID of the resource the operation is performed on
+	ResourceID string `json:"resourceID,omitempty"`
+	// Workspace is the ID of the PowerVS workspace the resource belongs to
+	Workspace string `json:"workspace,omitempty"`
+	Outcome   string `json:"outcome,omitempty"`
+	Error     string `json:"error,omitempty"`
+	// User is the IAM identity of the caller, the email of the user or the IAM ID of the service ID and trusted profile
+	User    string `json:"user,omitempty"`
+	Account string `json:"account,omitempty"`
+	Command string `json:"command,omitempty"`
 }
 
-type Audit struct {
-	file  *os.File
-	mutex *sync.Mutex
+var (
+	contextMu sync.Mutex
+	user      string
+	account   string
+	command   string
+)
+
+// SetIdentity sets the IAM identity and the account recorded in the entries
+func SetIdentity(u, a string) {
+	contextMu.Lock()
+	defer contextMu.Unlock()
+	user, account = u, a
 }
 
-type log struct {
-	Name      string    `json:"name"`
-	Operation string    `json:"op"`
-	Value     string    `json:"value"`
-	Timestamp time.Time `json:"timestamp"`
+// SetCommand sets the command recorded in the entries
+func SetCommand(c string) {
+	contextMu.Lock()
+	defer contextMu.Unlock()
+	command = c
 }
 
-func New(file string) *Audit {
-	logfile, err := os.OpenFile(file, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0755)
-	if err != nil {
-		klog.Fatalf("failed to open audit file: %s, err: %v", pkg.Options.AuditFile, err)
-	}
-	return &Audit{
-		file:  logfile,
-		mutex: &sync.Mutex{},
-	}
+// Log records the successful operation on the resource
+func Log(name, op, value string) {
+	Record(Entry{Name: name, Operation: op, Value: value}, nil)
 }
 
-func (a *Audit) Log(name, op, value string) {
-	a.mutex.Lock()
-	entry := log{
-		Name:      name,
-		Operation: op,
-		Value:     value,
-		Timestamp: time.Now().UTC(),
+// Record records the entry, the outcome is a failure if err is not nil
+func Record(e Entry, err error) {
+	if e.Timestamp.IsZero() {
+		e.Timestamp = time.Now().UTC()
 	}
-	jsonEntry, err := json.Marshal(entry)
+	e.Outcome = OutcomeSuccess
 	if err != nil {
-		klog.Errorf("json marshal error: %v", err)
+		e.Outcome, e.Error = OutcomeFailure, err.Error()
 	}
-	jsonEntry = append(jsonEntry, '\n')
-	if _, err := a.file.Write(jsonEntry); err != nil {
-		klog.Errorf("log failed with error, err: %v", err)
-	}
-	a.mutex.Unlock()
-}
+	contextMu.Lock()
+	e.User, e.Account, e.Command = user, account, command
+	contextMu.Unlock()
 
-func Delete(file string) {
-	check_file, err := os.Stat(file)
-	if err != nil {
-		klog.Errorf("cannot retrieve file stats, err: %v", err)
+	if Logger == nil {
+		klog.V(2).Infof("audit logger is not initialized, dropping the entry: %s %s %s", e.Name, e.Operation, e.Value)
 		return
 	}
-	if check_file.Size() == 0 {
-		os.Remove(file)
+	if err := Logger.Write(e); err != nil {
+		klog.Errorf("log failed with error, err: %v", err)
 	}
 }
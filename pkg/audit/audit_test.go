@@ -0,0 +1,94 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRecord(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	Logger = NewFile(path, 0)
+	t.Cleanup(func() {
+		Logger.Close()
+		Logger = nil
+		SetIdentity("", "")
+		SetCommand("")
+	})
+	SetIdentity("user@example.com", "abcd1234")
+	SetCommand("pvsadm delete dhcpserver")
+
+	Record(Entry{Name: "dhcpserver", Operation: "delete", Workspace: "ws-id", ResourceID: "dhcp-id"}, errors.New("not found"))
+	Log("vms", "delete", "ws:vm")
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("audit file mode = %v, want 0600", info.Mode().Perm())
+	}
+
+	entries, err := Query(path, Filter{})
+	if err != nil {
+		t.Fatalf("Query() returned error: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("Query() returned %d entries, want 2", len(entries))
+	}
+	got := entries[0]
+	got.Timestamp = time.Time{}
+	want := Entry{Name: "dhcpserver", Operation: "delete", Workspace: "ws-id", ResourceID: "dhcp-id", Outcome: OutcomeFailure, Error: "not found",
+		User: "user@example.com", Account: "abcd1234", Command: "pvsadm delete dhcpserver"}
+	if got != want {
+		t.Errorf("Record() wrote %+v, want %+v", got, want)
+	}
+	if entries[1].Outcome != OutcomeSuccess || entries[1].Value != "ws:vm" {
+		t.Errorf("Log() wrote %+v", entries[1])
+	}
+}
+
+func TestRecordWithoutLogger(t *testing.T) {
+	Logger = nil
+	Record(Entry{Name: "vms", Operation: "delete"}, nil)
+}
+
+func TestRotate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	a := NewFile(path, 200)
+	defer a.Close()
+
+	for i := 0; i < 20; i++ {
+		if err := a.Write(Entry{Name: "vms", Operation: "delete", Value: "ws:vm"}); err != nil {
+			t.Fatalf("Write() returned error: %v", err)
+		}
+	}
+	for _, file := range []string{path, backup(path, 1), backup(path, MaxBackups)} {
+		info, err := os.Stat(file)
+		if err != nil {
+			t.Fatalf("audit file is missing after the rotation, err: %v", err)
+		}
+		if info.Size() > 200 {
+			t.Errorf("%s size = %d, want <= 200", file, info.Size())
+		}
+	}
+	if _, err := os.Stat(backup(path, MaxBackups+1)); !os.IsNotExist(err) {
+		t.Errorf("audit file beyond the %d backups is kept", MaxBackups)
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// MaxBackups is the number of the rotated audit files kept next to the audit file
+const MaxBackups = 5
+
+// File writes the entries as JSON lines to the file, the file is created on the first entry and rotated once it grows over the max size
+type File struct {
+	path    string
+	maxSize int64
+	mutex   sync.Mutex
+	file    *os.File
+	size    int64
+}
+
+// NewFile returns the File sink for the path, maxSize in bytes, 0 disables the rotation
+func NewFile(path string, maxSize int64) *File {
+	return &File{
+		path:    path,
+		maxSize: maxSize,
+	}
+}
+
+func (f *File) Write(e Entry) error {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("json marshal error: %v", err)
+	}
+	line = append(line, '\n')
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.file == nil {
+		if err := f.open(); err != nil {
+			return err
+		}
+	}
+	if f.maxSize > 0 && f.size > 0 && f.size+int64(len(line)) > f.maxSize {
+		if err := f.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := f.file.Write(line)
+	f.size += int64(n)
+	return err
+}
+
+// Close closes the file, the next entry opens it again
+func (f *File) Close() error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.file == nil {
+		return nil
+	}
+	err := f.file.Close()
+	f.file = nil
+	return err
+}
+
+func (f *File) open() error {
+	file, err := os.OpenFile(f.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit file: %s, err: %v", f.path, err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to stat audit file: %s, err: %v", f.path, err)
+	}
+	f.file, f.size = file, info.Size()
+	return nil
+}
+
+// rotate shifts the audit file to <file>.1, the <file>.1 to <file>.2 and so on, dropping the oldest one
+func (f *File) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+	f.file = nil
+	for i := MaxBackups - 1; i > 0; i-- {
+		if err := os.Rename(backup(f.path, i), backup(f.path, i+1)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to rotate the audit file, err: %v", err)
+		}
+	}
+	if err := os.Rename(f.path, backup(f.path, 1)); err != nil {
+		return fmt.Errorf("failed to rotate the audit file, err: %v", err)
+	}
+	return f.open()
+}
+
+func backup(path string, i int) string {
+	return fmt.Sprintf("%s.%d", path, i)
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// Filter selects the entries returned by Query, the empty fields match all the entries
+type Filter struct {
+	Since     time.Time
+	Operation string
+	// Resource matches the name, e.g: vms, or the ID of the resource
+	Resource string
+}
+
+func (f Filter) match(e Entry) bool {
+	if !f.Since.IsZero() && e.Timestamp.Before(f.Since) {
+		return false
+	}
+	if f.Operation != "" && e.Operation != f.Operation {
+		return false
+	}
+	if f.Resource != "" && e.Name != f.Resource && e.ResourceID != f.Resource {
+		return false
+	}
+	return true
+}
+
+// Query reads the audit file along with the rotated ones and returns the entries matching the filter, oldest first
+func Query(path string, f Filter) ([]Entry, error) {
+	var entries []Entry
+	for i := MaxBackups; i >= 0; i-- {
+		file := path
+		if i > 0 {
+			file = backup(path, i)
+		}
+		e, err := read(file, f)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		entries = append(entries, e...)
+	}
+	return entries, nil
+}
+
+func read(file string, f Filter) ([]Entry, error) {
+	r, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var entries []Entry
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			klog.Warningf("skipping the malformed entry at %s:%d, err: %v", file, line, err)
+			continue
+		}
+		if f.match(e) {
+			entries = append(entries, e)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read the audit file: %s, err: %v", file, err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestQuery(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	now := time.Now().UTC()
+	backupLines := `{"name":"vms","op":"delete","value":"ws:old","timestamp":"` + now.Add(-48*time.Hour).Format(time.RFC3339) + `"}
+not a json line
+`
+	lines := `{"name":"ports","op":"create","resourceID":"port-id","timestamp":"` + now.Add(-time.Hour).Format(time.RFC3339) + `"}
+{"name":"vms","op":"delete","resourceID":"vm-id","timestamp":"` + now.Format(time.RFC3339) + `"}
+`
+	if err := os.WriteFile(backup(path, 1), []byte(backupLines), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(lines), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   []string
+	}{
+		{name: "all the entries oldest first", filter: Filter{}, want: []string{"vms", "ports", "vms"}},
+		{name: "since", filter: Filter{Since: now.Add(-2 * time.Hour)}, want: []string{"ports", "vms"}},
+		{name: "operation", filter: Filter{Operation: "create"}, want: []string{"ports"}},
+		{name: "resource name", filter: Filter{Resource: "vms"}, want: []string{"vms", "vms"}},
+		{name: "resource ID", filter: Filter{Resource: "vm-id"}, want: []string{"vms"}},
+		{name: "no match", filter: Filter{Operation: "delete", Resource: "ports"}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries, err := Query(path, tt.filter)
+			if err != nil {
+				t.Fatalf("Query() returned error: %v", err)
+			}
+			var got []string
+			for _, e := range entries {
+				got = append(got, e.Name)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Query() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Query() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestQueryMissingFile(t *testing.T) {
+	entries, err := Query(filepath.Join(t.TempDir(), "audit.log"), Filter{})
+	if err != nil || len(entries) != 0 {
+		t.Errorf("Query() = %v, %v, want no entries", entries, err)
+	}
+}
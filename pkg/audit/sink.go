@@ -0,0 +1,126 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg/config"
+)
+
+const (
+	SinkFile    = "file"
+	SinkSyslog  = "syslog"
+	SinkWebhook = "webhook"
+)
+
+// Sink ships the audit entries, e.g: to a file, a syslog server or a webhook
+type Sink interface {
+	Write(e Entry) error
+	// Close flushes the pending entries and releases the sink
+	Close() error
+}
+
+// multiSink writes the entries to all the sinks, a failing sink doesn't stop the others
+type multiSink []Sink
+
+func (m multiSink) Write(e Entry) error {
+	var errs []error
+	for _, s := range m {
+		if err := s.Write(e); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (m multiSink) Close() error {
+	var errs []error
+	for _, s := range m {
+		if err := s.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// NewSink returns the sink for the configured sinks, the entries are written to the file when no sinks are configured
+func NewSink(sinks []config.AuditSink, file string, maxSize int) (Sink, error) {
+	if len(sinks) == 0 {
+		return NewFile(file, mib(maxSize)), nil
+	}
+	var m multiSink
+	for i, c := range sinks {
+		s, err := newSink(c, file, maxSize)
+		if err != nil {
+			m.Close()
+			return nil, fmt.Errorf("invalid audit sink %d, err: %v", i, err)
+		}
+		m = append(m, s)
+	}
+	if len(m) == 1 {
+		return m[0], nil
+	}
+	return m, nil
+}
+
+func newSink(c config.AuditSink, file string, maxSize int) (Sink, error) {
+	switch c.Type {
+	case SinkFile:
+		if c.Path != "" {
+			file = c.Path
+		}
+		if c.MaxSize != 0 {
+			maxSize = c.MaxSize
+		}
+		return NewFile(file, mib(maxSize)), nil
+	case SinkSyslog:
+		return NewSyslog(c.Network, c.Address, c.Facility)
+	case SinkWebhook:
+		headers := make(map[string]string, len(c.Headers))
+		for k, v := range c.Headers {
+			headers[k] = os.ExpandEnv(v)
+		}
+		return NewWebhook(c.URL, headers, c.BatchSize, c.FlushInterval, c.MaxRetries)
+	default:
+		return nil, fmt.Errorf("unsupported type %q, supported are: [%s, %s, %s]", c.Type, SinkFile, SinkSyslog, SinkWebhook)
+	}
+}
+
+func mib(size int) int64 {
+	return int64(size) << 20
+}
+
+// FilePath returns the path of the file the entries are written to, the path of the first file sink when the sinks are
+// configured. It fails when none of the configured sinks is a file, e.g: only the syslog or the webhook sinks.
+func FilePath(sinks []config.AuditSink, file string) (string, error) {
+	if len(sinks) == 0 {
+		return file, nil
+	}
+	var types []string
+	for _, c := range sinks {
+		if c.Type == SinkFile {
+			if c.Path != "" {
+				return c.Path, nil
+			}
+			return file, nil
+		}
+		types = append(types, c.Type)
+	}
+	return "", fmt.Errorf("no file sink is configured to query, the audit entries are sent to the %s sinks set in the config file, query them there", strings.Join(types, ", "))
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg/config"
+)
+
+func TestNewSink(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "audit.log")
+
+	s, err := NewSink(nil, file, 10)
+	if err != nil {
+		t.Fatalf("NewSink() returned error: %v", err)
+	}
+	if f, ok := s.(*File); !ok || f.path != file || f.maxSize != 10<<20 {
+		t.Errorf("NewSink() without sinks = %#v, want the file sink of %s", s, file)
+	}
+
+	if _, err := NewSink([]config.AuditSink{{Type: "kafka"}}, file, 10); err == nil {
+		t.Errorf("NewSink() with an unsupported type returned no error")
+	}
+	if _, err := NewSink([]config.AuditSink{{Type: SinkFile}, {Type: SinkWebhook}}, file, 10); err == nil {
+		t.Errorf("NewSink() with a webhook without url returned no error")
+	}
+}
+
+func TestMultiSink(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	path := filepath.Join(t.TempDir(), "pvsadm.log")
+	sinks := []config.AuditSink{
+		{Type: SinkFile, Path: path},
+		{Type: SinkSyslog, Address: conn.LocalAddr().String()},
+	}
+	s, err := NewSink(sinks, "pvsadm_audit.log", 10)
+	if err != nil {
+		t.Fatalf("NewSink() returned error: %v", err)
+	}
+	if err := s.Write(Entry{Timestamp: time.Now(), Name: "ports", Operation: "create", ResourceID: "port-id"}); err != nil {
+		t.Fatalf("Write() returned error: %v", err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close() returned error: %v", err)
+	}
+
+	if got, err := FilePath(sinks, "pvsadm_audit.log"); err != nil || got != path {
+		t.Errorf("FilePath() = %s, %v, want %s", got, err, path)
+	}
+	entries, err := Query(path, Filter{Resource: "port-id"})
+	if err != nil || len(entries) != 1 {
+		t.Errorf("file sink entries = %v, %v, want the port", entries, err)
+	}
+	buf := make([]byte, 4096)
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, _, err := conn.ReadFrom(buf)
+	if err != nil {
+		t.Fatalf("syslog sink didn't receive the entry, err: %v", err)
+	}
+	if _, e := parseSyslog(t, string(buf[:n])); e.ResourceID != "port-id" {
+		t.Errorf("syslog sink entry = %+v", e)
+	}
+}
+
+func TestFilePath(t *testing.T) {
+	tests := []struct {
+		name    string
+		sinks   []config.AuditSink
+		want    string
+		wantErr bool
+	}{
+		{"no sinks", nil, "pvsadm_audit.log", false},
+		{"file sink without path", []config.AuditSink{{Type: SinkSyslog}, {Type: SinkFile}}, "pvsadm_audit.log", false},
+		{"file sink", []config.AuditSink{{Type: SinkFile, Path: "/var/log/pvsadm.log"}}, "/var/log/pvsadm.log", false},
+		{"no file sink", []config.AuditSink{{Type: SinkSyslog}, {Type: SinkWebhook}}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FilePath(tt.sinks, "pvsadm_audit.log")
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("FilePath() = %s, %v, want %s, error %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
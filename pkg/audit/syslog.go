@@ -0,0 +1,149 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	syslogAppName   = "pvsadm"
+	syslogMsgID     = "audit"
+	syslogTimeout   = 5 * time.Second
+	severityWarning = 4
+	severityNotice  = 5
+)
+
+var facilities = map[string]int{
+	"kern": 0, "user": 1, "mail": 2, "daemon": 3, "auth": 4, "syslog": 5, "lpr": 6, "news": 7,
+	"uucp": 8, "cron": 9, "authpriv": 10, "ftp": 11,
+	"local0": 16, "local1": 17, "local2": 18, "local3": 19, "local4": 20, "local5": 21, "local6": 22, "local7": 23,
+}
+
+// Syslog sends the entries as RFC5424 messages to the syslog server over UDP or TCP, the message carries the JSON entry.
+// The TCP messages are framed with the octet counting of RFC6587.
+type Syslog struct {
+	network  string
+	address  string
+	facility int
+	hostname string
+
+	mutex sync.Mutex
+	conn  net.Conn
+}
+
+// NewSyslog returns the Syslog sink, the connection is established on the first entry
+func NewSyslog(network, address, facility string) (*Syslog, error) {
+	if network == "" {
+		network = "udp"
+	}
+	if network != "udp" && network != "tcp" {
+		return nil, fmt.Errorf("unsupported syslog network %q, supported are: [udp, tcp]", network)
+	}
+	if address == "" {
+		return nil, fmt.Errorf("syslog address is required")
+	}
+	if facility == "" {
+		facility = "local0"
+	}
+	f, ok := facilities[facility]
+	if !ok {
+		return nil, fmt.Errorf("unknown syslog facility %q", facility)
+	}
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+	return &Syslog{
+		network:  network,
+		address:  address,
+		facility: f,
+		hostname: hostname,
+	}, nil
+}
+
+// format returns the RFC5424 message of the entry
+func (s *Syslog) format(e Entry) ([]byte, error) {
+	msg, err := json.Marshal(e)
+	if err != nil {
+		return nil, fmt.Errorf("json marshal error: %v", err)
+	}
+	severity := severityNotice
+	if e.Outcome == OutcomeFailure {
+		severity = severityWarning
+	}
+	header := fmt.Sprintf("<%d>1 %s %s %s %d %s - ", s.facility*8+severity, e.Timestamp.UTC().Format("2006-01-02T15:04:05.000000Z07:00"),
+		s.hostname, syslogAppName, os.Getpid(), syslogMsgID)
+	return append([]byte(header), msg...), nil
+}
+
+func (s *Syslog) Write(e Entry) error {
+	msg, err := s.format(e)
+	if err != nil {
+		return err
+	}
+	if s.network == "tcp" {
+		msg = append([]byte(fmt.Sprintf("%d ", len(msg))), msg...)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	// A broken TCP connection is noticed on the write, reconnect and send the message once more
+	for attempt := 0; ; attempt++ {
+		if err = s.send(msg); err == nil || attempt == 1 {
+			break
+		}
+		s.close()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to send the audit entry to the syslog server %s, err: %v", s.address, err)
+	}
+	return nil
+}
+
+func (s *Syslog) send(msg []byte) error {
+	if s.conn == nil {
+		conn, err := net.DialTimeout(s.network, s.address, syslogTimeout)
+		if err != nil {
+			return err
+		}
+		s.conn = conn
+	}
+	if err := s.conn.SetWriteDeadline(time.Now().Add(syslogTimeout)); err != nil {
+		return err
+	}
+	_, err := s.conn.Write(msg)
+	return err
+}
+
+func (s *Syslog) close() error {
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
+
+func (s *Syslog) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.close()
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+var rfc5424 = regexp.MustCompile(`^<(\d+)>1 \d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}\.\d{6}Z \S+ pvsadm \d+ audit - (\{.*\})$`)
+
+// parseSyslog checks the message is in the RFC5424 format and returns the priority and the entry it carries
+func parseSyslog(t *testing.T, msg string) (int, Entry) {
+	t.Helper()
+	m := rfc5424.FindStringSubmatch(msg)
+	if m == nil {
+		t.Fatalf("message is not in the RFC5424 format: %q", msg)
+	}
+	pri, _ := strconv.Atoi(m[1])
+	var e Entry
+	if err := json.Unmarshal([]byte(m[2]), &e); err != nil {
+		t.Fatalf("message doesn't carry the entry, err: %v", err)
+	}
+	return pri, e
+}
+
+func TestSyslogUDP(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	s, err := NewSyslog("udp", conn.LocalAddr().String(), "auth")
+	if err != nil {
+		t.Fatalf("NewSyslog() returned error: %v", err)
+	}
+	defer s.Close()
+	if err := s.Write(Entry{Timestamp: time.Now(), Name: "vms", Operation: "delete", ResourceID: "vm-id", Outcome: OutcomeFailure}); err != nil {
+		t.Fatalf("Write() returned error: %v", err)
+	}
+
+	buf := make([]byte, 4096)
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, _, err := conn.ReadFrom(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pri, e := parseSyslog(t, string(buf[:n]))
+	if want := 4*8 + severityWarning; pri != want {
+		t.Errorf("priority = %d, want %d", pri, want)
+	}
+	if e.ResourceID != "vm-id" {
+		t.Errorf("entry = %+v", e)
+	}
+}
+
+func TestSyslogTCP(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	// The server drops the first connection after a message to exercise the reconnect
+	messages := make(chan string, 3)
+	go func() {
+		for i := 0; ; i++ {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func(conn net.Conn, drop bool) {
+				defer conn.Close()
+				r := bufio.NewReader(conn)
+				for {
+					var size int
+					if _, err := fmt.Fscanf(r, "%d ", &size); err != nil {
+						return
+					}
+					msg := make([]byte, size)
+					if _, err := io.ReadFull(r, msg); err != nil {
+						return
+					}
+					messages <- string(msg)
+					if drop {
+						return
+					}
+				}
+			}(conn, i == 0)
+		}
+	}()
+
+	s, err := NewSyslog("tcp", l.Addr().String(), "")
+	if err != nil {
+		t.Fatalf("NewSyslog() returned error: %v", err)
+	}
+	defer s.Close()
+	for i := 0; i < 3; i++ {
+		if err := s.Write(Entry{Timestamp: time.Now(), Name: "vms", Operation: "delete", Value: strings.Repeat("x", i), Outcome: OutcomeSuccess}); err != nil {
+			t.Fatalf("Write() returned error: %v", err)
+		}
+		// Give the server the time to close the connection before the next write
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	var received int
+	timeout := time.After(5 * time.Second)
+	for received < 2 {
+		select {
+		case msg := <-messages:
+			pri, _ := parseSyslog(t, msg)
+			if want := 16*8 + severityNotice; pri != want {
+				t.Errorf("priority = %d, want %d", pri, want)
+			}
+			received++
+		case <-timeout:
+			t.Fatalf("received %d messages, want at least 2", received)
+		}
+	}
+}
+
+func TestNewSyslog(t *testing.T) {
+	tests := []struct {
+		name                       string
+		network, address, facility string
+		wantErr                    bool
+	}{
+		{name: "defaults", address: "127.0.0.1:514"},
+		{name: "tcp", network: "tcp", address: "127.0.0.1:514", facility: "local7"},
+		{name: "missing address", wantErr: true},
+		{name: "unsupported network", network: "unix", address: "/dev/log", wantErr: true},
+		{name: "unknown facility", address: "127.0.0.1:514", facility: "local8", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewSyslog(tt.network, tt.address, tt.facility)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewSyslog() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+const (
+	defaultBatchSize     = 100
+	defaultFlushInterval = 5 * time.Second
+	defaultMaxRetries    = 3
+	webhookTimeout       = 10 * time.Second
+)
+
+// Webhook posts the entries in batches as a JSON array to the URL, the batch is posted once it is full or when the
+// flush interval elapses. The failed requests are retried with an exponential backoff on the network errors and the
+// 429 and 5xx responses.
+type Webhook struct {
+	url       string
+	batchSize int
+	poster    *utils.Poster
+
+	mutex sync.Mutex
+	batch []Entry
+	// flushMutex serializes the flushes triggered by the full batches, the ticker and the Close to post the batches in
+	// the order the entries are recorded
+	flushMutex sync.Mutex
+	done       chan struct{}
+	wg         sync.WaitGroup
+}
+
+// NewWebhook returns the Webhook sink, the zero batchSize, flushInterval and maxRetries are set to the defaults
+func NewWebhook(u string, headers map[string]string, batchSize int, flushInterval time.Duration, maxRetries int) (*Webhook, error) {
+	if u == "" {
+		return nil, fmt.Errorf("webhook url is required")
+	}
+	if parsed, err := url.Parse(u); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return nil, fmt.Errorf("invalid webhook url %q", u)
+	}
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+	if flushInterval <= 0 {
+		flushInterval = defaultFlushInterval
+	}
+	if maxRetries <= 0 {
+		maxRetries = defaultMaxRetries
+	}
+	w := &Webhook{
+		url:       u,
+		batchSize: batchSize,
+		poster: &utils.Poster{
+			Client:  &http.Client{Timeout: webhookTimeout},
+			Headers: headers,
+			Retries: maxRetries,
+			Backoff: time.Second,
+		},
+		done: make(chan struct{}),
+	}
+	w.wg.Add(1)
+	go w.run(flushInterval)
+	return w, nil
+}
+
+func (w *Webhook) run(flushInterval time.Duration) {
+	defer w.wg.Done()
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := w.Flush(); err != nil {
+				klog.Errorf("log failed with error, err: %v", err)
+			}
+		case <-w.done:
+			return
+		}
+	}
+}
+
+func (w *Webhook) Write(e Entry) error {
+	w.mutex.Lock()
+	w.batch = append(w.batch, e)
+	full := len(w.batch) >= w.batchSize
+	w.mutex.Unlock()
+	if full {
+		return w.Flush()
+	}
+	return nil
+}
+
+// Flush posts the pending entries, after the batches taken by the flushes in progress
+func (w *Webhook) Flush() error {
+	w.flushMutex.Lock()
+	defer w.flushMutex.Unlock()
+	w.mutex.Lock()
+	batch := w.batch
+	w.batch = nil
+	w.mutex.Unlock()
+	if len(batch) == 0 {
+		return nil
+	}
+	body, err := json.Marshal(batch)
+	if err != nil {
+		return fmt.Errorf("json marshal error: %v", err)
+	}
+
+	if err := w.poster.Post(w.url, "application/json", body); err != nil {
+		return fmt.Errorf("failed to post %d audit entries to the webhook, err: %v", len(batch), err)
+	}
+	return nil
+}
+
+// Close stops the periodic flush and posts the pending entries
+func (w *Webhook) Close() error {
+	w.mutex.Lock()
+	select {
+	case <-w.done:
+	default:
+		close(w.done)
+	}
+	w.mutex.Unlock()
+	w.wg.Wait()
+	return w.Flush()
+}
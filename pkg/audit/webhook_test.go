@@ -0,0 +1,194 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// webhookServer records the batches posted to it, the first failures requests are answered with the status
+type webhookServer struct {
+	mutex    sync.Mutex
+	batches  [][]Entry
+	requests int
+	failures int
+	status   int
+}
+
+func (s *webhookServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.requests++
+	if r.Header.Get("Authorization") != "Bearer secret" || r.Header.Get("Content-Type") != "application/json" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if s.requests <= s.failures {
+		w.WriteHeader(s.status)
+		return
+	}
+	var batch []Entry
+	if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	s.batches = append(s.batches, batch)
+}
+
+func (s *webhookServer) sizes() []int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var sizes []int
+	for _, b := range s.batches {
+		sizes = append(sizes, len(b))
+	}
+	return sizes
+}
+
+func TestWebhook(t *testing.T) {
+	tests := []struct {
+		name         string
+		failures     int
+		status       int
+		entries      int
+		wantErr      bool
+		wantRequests int
+		wantBatches  []int
+	}{
+		{name: "batches", entries: 5, wantRequests: 3, wantBatches: []int{2, 2, 1}},
+		{name: "retry on server error", failures: 2, status: http.StatusServiceUnavailable, entries: 2, wantRequests: 3, wantBatches: []int{2}},
+		{name: "retry on throttling", failures: 1, status: http.StatusTooManyRequests, entries: 1, wantRequests: 2, wantBatches: []int{1}},
+		{name: "retries exhausted", failures: 10, status: http.StatusInternalServerError, entries: 2, wantErr: true, wantRequests: 4},
+		{name: "no retry on client error", failures: 10, status: http.StatusBadRequest, entries: 2, wantErr: true, wantRequests: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &webhookServer{failures: tt.failures, status: tt.status}
+			server := httptest.NewServer(s)
+			defer server.Close()
+
+			w, err := NewWebhook(server.URL, map[string]string{"Authorization": "Bearer secret"}, 2, time.Hour, 3)
+			if err != nil {
+				t.Fatalf("NewWebhook() returned error: %v", err)
+			}
+			w.poster.Backoff = time.Millisecond
+
+			var errs []error
+			for i := 0; i < tt.entries; i++ {
+				if err := w.Write(Entry{Name: "vms", Operation: "delete"}); err != nil {
+					errs = append(errs, err)
+				}
+			}
+			// Close posts the entries left over from the full batches
+			if err := w.Close(); err != nil {
+				errs = append(errs, err)
+			}
+			if (len(errs) != 0) != tt.wantErr {
+				t.Errorf("webhook errors = %v, wantErr %v", errs, tt.wantErr)
+			}
+			if s.requests != tt.wantRequests {
+				t.Errorf("requests = %d, want %d", s.requests, tt.wantRequests)
+			}
+			got := s.sizes()
+			if len(got) != len(tt.wantBatches) {
+				t.Fatalf("batches = %v, want %v", got, tt.wantBatches)
+			}
+			for i := range got {
+				if got[i] != tt.wantBatches[i] {
+					t.Errorf("batches = %v, want %v", got, tt.wantBatches)
+				}
+			}
+		})
+	}
+}
+
+func TestWebhookFlushInterval(t *testing.T) {
+	s := &webhookServer{}
+	server := httptest.NewServer(s)
+	defer server.Close()
+
+	w, err := NewWebhook(server.URL, map[string]string{"Authorization": "Bearer secret"}, 100, 10*time.Millisecond, 0)
+	if err != nil {
+		t.Fatalf("NewWebhook() returned error: %v", err)
+	}
+	defer w.Close()
+	if err := w.Write(Entry{Name: "vms", Operation: "delete"}); err != nil {
+		t.Fatalf("Write() returned error: %v", err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for len(s.sizes()) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("the pending entry isn't posted after the flush interval")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestWebhookOrder(t *testing.T) {
+	s := &webhookServer{failures: 1, status: http.StatusServiceUnavailable}
+	server := httptest.NewServer(s)
+	defer server.Close()
+
+	w, err := NewWebhook(server.URL, map[string]string{"Authorization": "Bearer secret"}, 1, time.Hour, 3)
+	if err != nil {
+		t.Fatalf("NewWebhook() returned error: %v", err)
+	}
+	w.poster.Backoff = 100 * time.Millisecond
+
+	// The first batch is retried after the backoff, the second batch must not overtake it
+	errs := make(chan error, 1)
+	go func() { errs <- w.Write(Entry{Name: "vms", Operation: "delete", ResourceID: "1"}) }()
+	for {
+		s.mutex.Lock()
+		requests := s.requests
+		s.mutex.Unlock()
+		if requests != 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if err := w.Write(Entry{Name: "vms", Operation: "delete", ResourceID: "2"}); err != nil {
+		t.Errorf("Write() returned error: %v", err)
+	}
+	if err := <-errs; err != nil {
+		t.Errorf("Write() returned error: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Errorf("Close() returned error: %v", err)
+	}
+
+	var got []string
+	for _, b := range s.batches {
+		for _, e := range b {
+			got = append(got, e.ResourceID)
+		}
+	}
+	if len(got) != 2 || got[0] != "1" || got[1] != "2" {
+		t.Errorf("posted entries = %v, want [1 2]", got)
+	}
+}
+
+func TestNewWebhook(t *testing.T) {
+	for _, u := range []string{"", "ftp://audit.example.com", "://audit"} {
+		if _, err := NewWebhook(u, nil, 0, 0, 0); err == nil {
+			t.Errorf("NewWebhook(%q) returned no error", u)
+		}
+	}
+}
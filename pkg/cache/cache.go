@@ -0,0 +1,248 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+const (
+	metaSuffix = ".json"
+	tmpSuffix  = ".tmp"
+)
+
+// Cache is a content addressed store for the downloaded images
+type Cache struct {
+	Dir string
+}
+
+// Entry holds the metadata of the cached image
+type Entry struct {
+	Key      string    `json:"key"`
+	URL      string    `json:"url"`
+	ETag     string    `json:"etag,omitempty"`
+	Checksum string    `json:"checksum,omitempty"`
+	Size     int64     `json:"size"`
+	Created  time.Time `json:"created"`
+	LastUsed time.Time `json:"lastUsed"`
+	// File is the path to the cached image
+	File string `json:"-"`
+}
+
+// DefaultDir returns the default image cache directory, e.g: ~/.cache/pvsadm/images
+func DefaultDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "pvsadm", "images")
+}
+
+// New returns the cache stored in the dir, the directory is created if not present
+func New(dir string) (*Cache, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("image cache directory is not set")
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create the image cache directory %s, err: %v", dir, err)
+	}
+	return &Cache{Dir: dir}, nil
+}
+
+// Key generates the cache key for the url, the content is identified either by the checksum or the ETag
+func Key(url, etag, checksum string) string {
+	h := sha256.New()
+	h.Write([]byte(url + "\n"))
+	if checksum != "" {
+		h.Write([]byte("sha256:" + strings.ToLower(checksum)))
+	} else {
+		h.Write([]byte("etag:" + etag))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (c *Cache) dataFile(key string) string {
+	return filepath.Join(c.Dir, key)
+}
+
+func (c *Cache) metaFile(key string) string {
+	return filepath.Join(c.Dir, key+metaSuffix)
+}
+
+func (c *Cache) readEntry(key string) (*Entry, error) {
+	content, err := os.ReadFile(c.metaFile(key))
+	if err != nil {
+		return nil, err
+	}
+	entry := &Entry{}
+	if err := json.Unmarshal(content, entry); err != nil {
+		return nil, fmt.Errorf("failed to parse the cache entry %s, err: %v", key, err)
+	}
+	entry.File = c.dataFile(key)
+	return entry, nil
+}
+
+func (c *Cache) writeEntry(entry *Entry) error {
+	content, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := c.metaFile(entry.Key) + tmpSuffix
+	if err := os.WriteFile(tmp, content, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, c.metaFile(entry.Key))
+}
+
+// Get returns the cached entry for the key and marks it as recently used
+func (c *Cache) Get(key string) (*Entry, bool) {
+	entry, err := c.readEntry(key)
+	if err != nil {
+		return nil, false
+	}
+	info, err := os.Stat(entry.File)
+	if err != nil || info.Size() != entry.Size {
+		klog.V(1).Infof("Cached image %s is corrupted, removing it", entry.File)
+		_ = c.Remove(key)
+		return nil, false
+	}
+	entry.LastUsed = time.Now().UTC()
+	if err := c.writeEntry(entry); err != nil {
+		klog.V(1).Infof("failed to update the cache entry %s, err: %v", key, err)
+	}
+	return entry, true
+}
+
+// Put adds the file into the cache under the key
+func (c *Cache) Put(key, url, etag, checksum, file string) (*Entry, error) {
+	info, err := os.Stat(file)
+	if err != nil {
+		return nil, err
+	}
+	tmp := c.dataFile(key) + tmpSuffix
+	if err := linkOrCopy(file, tmp); err != nil {
+		return nil, fmt.Errorf("failed to add %s into the image cache, err: %v", file, err)
+	}
+	if err := os.Rename(tmp, c.dataFile(key)); err != nil {
+		os.Remove(tmp)
+		return nil, err
+	}
+	now := time.Now().UTC()
+	entry := &Entry{
+		Key:      key,
+		URL:      url,
+		ETag:     etag,
+		Checksum: checksum,
+		Size:     info.Size(),
+		Created:  now,
+		LastUsed: now,
+		File:     c.dataFile(key),
+	}
+	if err := c.writeEntry(entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// Fetch places the cached image for the key at the dest
+func (c *Cache) Fetch(key, dest string) error {
+	return linkOrCopy(c.dataFile(key), dest)
+}
+
+// List returns all the cache entries sorted by the last usage, most recently used first
+func (c *Cache) List() ([]*Entry, error) {
+	matches, err := filepath.Glob(filepath.Join(c.Dir, "*"+metaSuffix))
+	if err != nil {
+		return nil, err
+	}
+	var entries []*Entry
+	for _, match := range matches {
+		entry, err := c.readEntry(strings.TrimSuffix(filepath.Base(match), metaSuffix))
+		if err != nil {
+			klog.V(1).Infof("skipping the invalid cache entry %s, err: %v", match, err)
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].LastUsed.After(entries[j].LastUsed)
+	})
+	return entries, nil
+}
+
+// Remove deletes the cache entry for the key
+func (c *Cache) Remove(key string) error {
+	if err := os.Remove(c.dataFile(key)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := os.Remove(c.metaFile(key)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// Prune removes the entries not used in the olderThan duration and then the least recently used entries
+// until the total size of the cache is within the maxSize in bytes, 0 disables the respective limit.
+func (c *Cache) Prune(maxSize int64, olderThan time.Duration) ([]*Entry, error) {
+	entries, err := c.List()
+	if err != nil {
+		return nil, err
+	}
+	var removed []*Entry
+	var size int64
+	for _, entry := range entries {
+		if (olderThan != 0 && time.Since(entry.LastUsed) > olderThan) || (maxSize != 0 && size+entry.Size > maxSize) {
+			if err := c.Remove(entry.Key); err != nil {
+				return removed, err
+			}
+			removed = append(removed, entry)
+			continue
+		}
+		size += entry.Size
+	}
+	return removed, nil
+}
+
+// linkOrCopy hard links the src to dest and falls back to copy, e.g: when both are on different filesystems
+func linkOrCopy(src, dest string) error {
+	if err := os.Link(src, dest); err == nil {
+		return nil
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	if _, err = io.Copy(out, in); err != nil {
+		return err
+	}
+	return out.Sync()
+}
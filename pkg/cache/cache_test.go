@@ -0,0 +1,126 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestKey(t *testing.T) {
+	tests := []struct {
+		name              string
+		url1, etag1, sum1 string
+		url2, etag2, sum2 string
+		wantEqual         bool
+	}{
+		{"same url and etag", "http://a/img", "e1", "", "http://a/img", "e1", "", true},
+		{"same url different etag", "http://a/img", "e1", "", "http://a/img", "e2", "", false},
+		{"checksum takes precedence over etag", "http://a/img", "e1", "ABC", "http://a/img", "e2", "abc", true},
+		{"different url same checksum", "http://a/img", "", "abc", "http://b/img", "", "abc", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Key(tt.url1, tt.etag1, tt.sum1) == Key(tt.url2, tt.etag2, tt.sum2); got != tt.wantEqual {
+				t.Errorf("Key() equal = %v, want %v", got, tt.wantEqual)
+			}
+		})
+	}
+}
+
+func TestCache(t *testing.T) {
+	c, err := New(filepath.Join(t.TempDir(), "images"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := filepath.Join(t.TempDir(), "image.qcow2")
+	if err := os.WriteFile(src, []byte("qcow2 content"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	key := Key("http://example.com/image.qcow2", "etag", "")
+	if _, ok := c.Get(key); ok {
+		t.Fatalf("Get() found the entry in an empty cache")
+	}
+	if _, err := c.Put(key, "http://example.com/image.qcow2", "etag", "", src); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	entry, ok := c.Get(key)
+	if !ok {
+		t.Fatalf("Get() entry not found after Put()")
+	}
+	if entry.Size != int64(len("qcow2 content")) {
+		t.Errorf("Get() size = %d, want %d", entry.Size, len("qcow2 content"))
+	}
+
+	dest := filepath.Join(t.TempDir(), "image.qcow2")
+	if err := c.Fetch(key, dest); err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	if content, _ := os.ReadFile(dest); string(content) != "qcow2 content" {
+		t.Errorf("Fetch() content = %s", content)
+	}
+
+	if err := c.Remove(key); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+	if entries, _ := c.List(); len(entries) != 0 {
+		t.Errorf("List() = %d entries after Remove(), want 0", len(entries))
+	}
+}
+
+func TestCachePrune(t *testing.T) {
+	c, err := New(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := filepath.Join(t.TempDir(), "image.qcow2")
+	if err := os.WriteFile(src, make([]byte, 10), 0644); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"old", "middle", "new"} {
+		if _, err := c.Put(key, "http://example.com/"+key, "", "sum", src); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	// mark the "old" entry as unused for a day
+	entry, _ := c.readEntry("old")
+	entry.LastUsed = time.Now().Add(-24 * time.Hour)
+	if err := c.writeEntry(entry); err != nil {
+		t.Fatal(err)
+	}
+
+	removed, err := c.Prune(0, time.Hour)
+	if err != nil {
+		t.Fatalf("Prune() error = %v", err)
+	}
+	if len(removed) != 1 || removed[0].Key != "old" {
+		t.Errorf("Prune() by age removed = %v, want [old]", removed)
+	}
+
+	removed, err = c.Prune(15, 0)
+	if err != nil {
+		t.Fatalf("Prune() error = %v", err)
+	}
+	if len(removed) != 1 || removed[0].Key != "middle" {
+		t.Errorf("Prune() by size removed = %v, want [middle]", removed)
+	}
+	if _, ok := c.Get("new"); !ok {
+		t.Errorf("Prune() removed the most recently used entry")
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"k8s.io/klog/v2"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/config"
+)
+
+const (
+	// defaultIAMClient is the public client ID and secret of the IBM Cloud CLI, the IAM issues the refresh tokens
+	// exchanged for the tokens of the other accounts only to the registered clients and the API key alone isn't one
+	defaultIAMClient = "bx"
+	accountTimeout   = 30 * time.Second
+)
+
+// accountClient is used for the requests to the accounts and the IAM token endpoints
+var accountClient = &http.Client{Timeout: accountTimeout}
+
+// Account is the IBM Cloud account accessible with the credentials
+type Account struct {
+	ID    string
+	Name  string
+	State string
+}
+
+// SelectedAccount returns the account passed via --account-id, or else the one selected in the config file. The
+// account of the credentials is used if none is selected.
+func SelectedAccount() string {
+	if pkg.Options.AccountID != "" {
+		return pkg.Options.AccountID
+	}
+	cfg, err := config.Load()
+	if err != nil {
+		klog.Warningf("Ignoring the account selected in the config file: %v", err)
+		return ""
+	}
+	return cfg.Account
+}
+
+// ListAccounts returns the accounts accessible by the user
+func (c *Client) ListAccounts() ([]Account, error) {
+	endpoint := c.Endpoints[ACEndpoint]
+	if endpoint == "" {
+		endpoint = Environments[DefaultEnvProd][ACEndpoint]
+	}
+	var accounts []Account
+	next := "/coe/v2/accounts"
+	for next != "" {
+		req, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(endpoint, "/")+next, http.NoBody)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.Authenticator.Authenticate(req); err != nil {
+			return nil, err
+		}
+		var page struct {
+			NextURL   *string `json:"next_url"`
+			Resources []struct {
+				Metadata struct {
+					GUID string `json:"guid"`
+				} `json:"metadata"`
+				Entity struct {
+					Name  string `json:"name"`
+					State string `json:"state"`
+				} `json:"entity"`
+			} `json:"resources"`
+		}
+		if err := doJSON(accountClient, req, &page); err != nil {
+			return nil, fmt.Errorf("failed to list the accounts, err: %v", err)
+		}
+		for _, r := range page.Resources {
+			accounts = append(accounts, Account{ID: r.Metadata.GUID, Name: r.Entity.Name, State: r.Entity.State})
+		}
+		next = ""
+		if page.NextURL != nil {
+			next = *page.NextURL
+		}
+	}
+	return accounts, nil
+}
+
+// accountAuthenticator obtains the tokens for the account other than the one the API key belongs to, the same way as
+// the IBM Cloud CLI switches the accounts: the refresh token of the API key is exchanged for a token of the account.
+// The client ID and secret presented to the IAM are set with the IBMCLOUD_IAM_CLIENT_ID and IBMCLOUD_IAM_CLIENT_SECRET
+// environment variables, e.g: for the accounts restricting the IAM clients, the IBM Cloud CLI client is used otherwise.
+type accountAuthenticator struct {
+	apiKey       string
+	url          string
+	account      string
+	clientID     string
+	clientSecret string
+	client       *http.Client
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// iamTokenResponse is the response of the IAM token endpoint
+type iamTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	Expiration   int64  `json:"expiration"`
+}
+
+func newAccountAuthenticator(apiKey, iamURL, account string) *accountAuthenticator {
+	if iamURL == "" {
+		iamURL = Environments[DefaultEnvProd][TPEndpoint]
+	}
+	a := &accountAuthenticator{
+		apiKey:       apiKey,
+		url:          strings.TrimSuffix(iamURL, "/") + "/identity/token",
+		account:      account,
+		clientID:     os.Getenv("IBMCLOUD_IAM_CLIENT_ID"),
+		clientSecret: os.Getenv("IBMCLOUD_IAM_CLIENT_SECRET"),
+		client:       accountClient,
+	}
+	if a.clientID == "" {
+		a.clientID, a.clientSecret = defaultIAMClient, defaultIAMClient
+	}
+	return a
+}
+
+func (a *accountAuthenticator) AuthenticationType() string {
+	return core.AUTHTYPE_IAM
+}
+
+func (a *accountAuthenticator) Validate() error {
+	if a.apiKey == "" || a.account == "" {
+		return fmt.Errorf("both the API key and the account are required")
+	}
+	return nil
+}
+
+func (a *accountAuthenticator) Authenticate(req *http.Request) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.token == "" || time.Now().Add(tokenExpiryWindow).After(a.expiry) {
+		if err := a.requestToken(); err != nil {
+			return err
+		}
+	}
+	req.Header.Set("Authorization", "Bearer "+a.token)
+	return nil
+}
+
+func (a *accountAuthenticator) requestToken() error {
+	apiKeyToken, err := a.post(url.Values{"grant_type": {"urn:ibm:params:oauth:grant-type:apikey"}, "apikey": {a.apiKey}})
+	if err != nil {
+		return err
+	}
+	token, err := a.post(url.Values{"grant_type": {"refresh_token"}, "refresh_token": {apiKeyToken.RefreshToken}, "bss_account": {a.account}})
+	if err != nil {
+		return fmt.Errorf("failed to obtain the token for the account %s, err: %v", a.account, err)
+	}
+	a.token, a.expiry = token.AccessToken, time.Unix(token.Expiration, 0)
+	return nil
+}
+
+func (a *accountAuthenticator) post(form url.Values) (*iamTokenResponse, error) {
+	req, err := http.NewRequest(http.MethodPost, a.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(a.clientID, a.clientSecret)
+	token := &iamTokenResponse{}
+	if err := doJSON(a.client, req, token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// doJSON sends the request and decodes the JSON response into v
+func doJSON(client *http.Client, req *http.Request, v interface{}) error {
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s %s returned %s: %s", req.Method, req.URL.Path, resp.Status, strings.TrimSpace(string(body)))
+	}
+	return json.Unmarshal(body, v)
+}
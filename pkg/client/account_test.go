@@ -0,0 +1,117 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func Test_accountAuthenticator(t *testing.T) {
+	var grants []string
+	clientID, clientSecret := "bx", "bx"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != clientID || pass != clientSecret {
+			http.Error(w, "client credentials missing", http.StatusUnauthorized)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		grants = append(grants, r.Form.Get("grant_type"))
+		resp := iamTokenResponse{Expiration: time.Now().Add(time.Hour).Unix()}
+		switch r.Form.Get("grant_type") {
+		case "urn:ibm:params:oauth:grant-type:apikey":
+			if r.Form.Get("apikey") != "key" {
+				http.Error(w, "invalid API key", http.StatusBadRequest)
+				return
+			}
+			resp.AccessToken, resp.RefreshToken = "default-account", "refresh"
+		case "refresh_token":
+			if r.Form.Get("refresh_token") != "refresh" || r.Form.Get("bss_account") != "other" {
+				http.Error(w, "invalid refresh token or account", http.StatusBadRequest)
+				return
+			}
+			resp.AccessToken = "other-account"
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	auth := newAccountAuthenticator("key", server.URL, "other")
+	for i := 0; i < 2; i++ {
+		token, err := bearerToken(auth)
+		if err != nil {
+			t.Fatalf("Authenticate() returned error: %v", err)
+		}
+		if token != "other-account" {
+			t.Errorf("Authenticate() token = %s, want other-account", token)
+		}
+	}
+	if want := []string{"urn:ibm:params:oauth:grant-type:apikey", "refresh_token"}; !reflect.DeepEqual(grants, want) {
+		t.Errorf("token requests = %v, want %v", grants, want)
+	}
+
+	if _, err := bearerToken(newAccountAuthenticator("key", server.URL, "unknown")); err == nil {
+		t.Errorf("Authenticate() for an inaccessible account returned no error")
+	}
+
+	clientID, clientSecret = "pvsadm", "secret"
+	if _, err := bearerToken(newAccountAuthenticator("key", server.URL, "other")); err == nil {
+		t.Errorf("Authenticate() with the default client returned no error, want the custom client required")
+	}
+	t.Setenv("IBMCLOUD_IAM_CLIENT_ID", clientID)
+	t.Setenv("IBMCLOUD_IAM_CLIENT_SECRET", clientSecret)
+	if _, err := bearerToken(newAccountAuthenticator("key", server.URL, "other")); err != nil {
+		t.Errorf("Authenticate() with the client set in the environment returned error: %v", err)
+	}
+}
+
+func TestClient_ListAccounts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Query().Get("page") == "" {
+			fmt.Fprint(w, `{"next_url": "/coe/v2/accounts?page=2", "resources": [{"metadata": {"guid": "1"}, "entity": {"name": "dev", "state": "ACTIVE"}}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"next_url": null, "resources": [{"metadata": {"guid": "2"}, "entity": {"name": "ci", "state": "SUSPENDED"}}]}`)
+	}))
+	defer server.Close()
+
+	auth, err := core.NewBearerTokenAuthenticator("token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &Client{Authenticator: auth, Endpoints: map[string]string{ACEndpoint: server.URL}}
+	got, err := c.ListAccounts()
+	if err != nil {
+		t.Fatalf("ListAccounts() returned error: %v", err)
+	}
+	want := []Account{{ID: "1", Name: "dev", State: "ACTIVE"}, {ID: "2", Name: "ci", State: "SUSPENDED"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListAccounts() = %v, want %v", got, want)
+	}
+}
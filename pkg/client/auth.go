@@ -0,0 +1,180 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
+	"github.com/golang-jwt/jwt/v5"
+	"k8s.io/klog/v2"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+)
+
+// trustedProfileIDPrefix tells apart the IDs of the trusted profiles from their names
+const trustedProfileIDPrefix = "Profile-"
+
+// GetAuthenticator returns the authenticator for the credentials in the order of precedence: the IAM token passed via
+// --iam-token, the trusted profile passed via --trusted-profile assumed with the compute resource token, the API key,
+// and the IBMCLOUD_* environment variables of the IBM Cloud SDK, e.g: IBMCLOUD_AUTH_TYPE=container
+func GetAuthenticator(iamURL string) (core.Authenticator, error) {
+	opt := pkg.Options
+	switch {
+	case opt.IAMToken != "":
+		klog.V(1).Info("Using the IAM token to authenticate")
+		return core.NewBearerTokenAuthenticator(strings.TrimPrefix(opt.IAMToken, "Bearer "))
+	case opt.TrustedProfile != "":
+		klog.V(1).Infof("Using the trusted profile %s to authenticate", opt.TrustedProfile)
+		builder := core.NewContainerAuthenticatorBuilder().SetCRTokenFilename(opt.CRTokenFile).SetURL(iamURL)
+		if strings.HasPrefix(opt.TrustedProfile, trustedProfileIDPrefix) {
+			builder.SetIAMProfileID(opt.TrustedProfile)
+		} else {
+			builder.SetIAMProfileName(opt.TrustedProfile)
+		}
+		auth, err := builder.Build()
+		if err != nil {
+			return nil, err
+		}
+		return withTokenCache(auth, "trusted-profile:"+opt.TrustedProfile+":"+opt.CRTokenFile, iamURL), nil
+	case opt.APIKey != "":
+		if account := SelectedAccount(); account != "" {
+			klog.V(1).Infof("Using the API key to authenticate to the account %s", account)
+			return withTokenCache(newAccountAuthenticator(opt.APIKey, iamURL, account), "apikey:"+opt.APIKey+":"+account, iamURL), nil
+		}
+		auth, err := core.NewIamAuthenticatorBuilder().SetApiKey(opt.APIKey).SetURL(iamURL).Build()
+		if err != nil {
+			return nil, err
+		}
+		return withTokenCache(auth, "apikey:"+opt.APIKey, iamURL), nil
+	}
+	auth, err := core.GetAuthenticatorFromEnvironment(serviceIBMCloud)
+	if err != nil {
+		return nil, err
+	}
+	if auth == nil {
+		return nil, fmt.Errorf("authenticator can't be nil, please set proper authentication")
+	}
+	return auth, nil
+}
+
+// withTokenCache wraps the authenticator with the on-disk token cache when enabled via --token-cache
+func withTokenCache(auth core.Authenticator, credentials, iamURL string) core.Authenticator {
+	dir := TokenCacheDir()
+	if !pkg.Options.TokenCache || dir == "" {
+		return auth
+	}
+	return newTokenCache(auth, dir, credentials, iamURL)
+}
+
+// bearerToken returns the token obtained by the authenticator
+func bearerToken(auth core.Authenticator) (string, error) {
+	// fake request to get a bearer token from the request header
+	req, err := http.NewRequestWithContext(context.TODO(), http.MethodGet, "http://example.com", http.NoBody)
+	if err != nil {
+		return "", err
+	}
+	if err := auth.Authenticate(req); err != nil {
+		return "", err
+	}
+	return strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer "), nil
+}
+
+// tokenClaims returns the claims of the token, the signature isn't verified as the token is issued to us by IAM
+func tokenClaims(bearerToken string) (jwt.MapClaims, error) {
+	token, _, err := jwt.NewParser().ParseUnverified(bearerToken, jwt.MapClaims{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the IAM token, err: %v", err)
+	}
+	return token.Claims.(jwt.MapClaims), nil
+}
+
+func GetAccountID(auth core.Authenticator) (string, error) {
+	bearerToken, err := bearerToken(auth)
+	if err != nil {
+		return "", err
+	}
+	claims, err := tokenClaims(bearerToken)
+	if err != nil {
+		return "", err
+	}
+	account, ok := claims["account"].(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("account is missing in the IAM token")
+	}
+	bss, ok := account["bss"].(string)
+	if !ok {
+		return "", fmt.Errorf("account ID is missing in the IAM token")
+	}
+	return bss, nil
+}
+
+// GetIdentity returns the IAM identity the token is issued to, the email of the user or the IAM ID of the service ID and trusted profile
+func GetIdentity(auth core.Authenticator) (string, error) {
+	bearerToken, err := bearerToken(auth)
+	if err != nil {
+		return "", err
+	}
+	claims, err := tokenClaims(bearerToken)
+	if err != nil {
+		return "", err
+	}
+	for _, claim := range []string{"email", "iam_id", "sub"} {
+		if id, ok := claims[claim].(string); ok && id != "" {
+			return id, nil
+		}
+	}
+	return "", fmt.Errorf("identity is missing in the IAM token")
+}
+
+// cosCredentials returns the credentials for the COS instance, the tokens are obtained with the authenticator of the client
+func (c *Client) cosCredentials(instanceID string) *credentials.Credentials {
+	return ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), func() (*token.Token, error) {
+		bearerToken, err := bearerToken(c.Authenticator)
+		if err != nil {
+			return nil, err
+		}
+		claims, err := tokenClaims(bearerToken)
+		if err != nil {
+			return nil, err
+		}
+		exp, err := claims.GetExpirationTime()
+		if err != nil || exp == nil {
+			return nil, fmt.Errorf("expiration is missing in the IAM token")
+		}
+		return &token.Token{
+			AccessToken: bearerToken,
+			TokenType:   "Bearer",
+			ExpiresIn:   int64(time.Until(exp.Time).Seconds()),
+			Expiration:  exp.Unix(),
+		}, nil
+	}, c.iamTokenURL(), instanceID)
+}
+
+// iamTokenURL returns the IAM token endpoint of the environment
+func (c *Client) iamTokenURL() string {
+	if iam := c.Endpoints[TPEndpoint]; iam != "" {
+		return strings.TrimSuffix(iam, "/") + "/identity/token"
+	}
+	return AuthEndpoint
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+)
+
+func TestGetAuthenticator(t *testing.T) {
+	defer func(apiKey, iamToken, trustedProfile string) {
+		pkg.Options.APIKey, pkg.Options.IAMToken, pkg.Options.TrustedProfile = apiKey, iamToken, trustedProfile
+	}(pkg.Options.APIKey, pkg.Options.IAMToken, pkg.Options.TrustedProfile)
+	setConfig(t, "")
+	t.Setenv("IBMCLOUD_AUTH_TYPE", "")
+	t.Setenv("IBMCLOUD_APIKEY", "")
+
+	tests := []struct {
+		name           string
+		apiKey         string
+		iamToken       string
+		trustedProfile string
+		want           string
+		wantProfile    string
+	}{
+		{"api key", "key", "", "", core.AUTHTYPE_IAM, ""},
+		{"iam token takes precedence", "key", "token", "", core.AUTHTYPE_BEARER_TOKEN, ""},
+		{"trusted profile by name", "key", "", "ci-runner", core.AUTHTYPE_CONTAINER, "ci-runner"},
+		{"trusted profile by ID", "", "", "Profile-1234", core.AUTHTYPE_CONTAINER, "Profile-1234"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pkg.Options.APIKey, pkg.Options.IAMToken, pkg.Options.TrustedProfile = tt.apiKey, tt.iamToken, tt.trustedProfile
+			auth, err := GetAuthenticator("https://iam.test.cloud.ibm.com")
+			if err != nil {
+				t.Fatalf("GetAuthenticator() returned error: %v", err)
+			}
+			if got := auth.AuthenticationType(); got != tt.want {
+				t.Errorf("GetAuthenticator() type = %s, want %s", got, tt.want)
+			}
+			if c, ok := auth.(*core.ContainerAuthenticator); ok {
+				if got := c.IAMProfileName + c.IAMProfileID; got != tt.wantProfile {
+					t.Errorf("GetAuthenticator() profile = %s, want %s", got, tt.wantProfile)
+				}
+				if c.URL != "https://iam.test.cloud.ibm.com" {
+					t.Errorf("GetAuthenticator() URL = %s", c.URL)
+				}
+			}
+		})
+	}
+
+	pkg.Options.APIKey, pkg.Options.IAMToken, pkg.Options.TrustedProfile = "", "", ""
+	if _, err := GetAuthenticator(""); err == nil {
+		t.Errorf("GetAuthenticator() without credentials returned no error")
+	}
+}
+
+func TestGetAccountID(t *testing.T) {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"account": map[string]interface{}{"bss": "abcd1234"},
+		"exp":     4102444800,
+	}).SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth, err := core.NewBearerTokenAuthenticator(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := GetAccountID(auth)
+	if err != nil {
+		t.Fatalf("GetAccountID() returned error: %v", err)
+	}
+	if got != "abcd1234" {
+		t.Errorf("GetAccountID() = %s, want abcd1234", got)
+	}
+
+	claims, err := tokenClaims(token)
+	if err != nil {
+		t.Fatalf("tokenClaims() returned error: %v", err)
+	}
+	if exp, _ := claims.GetExpirationTime(); exp == nil || exp.Unix() != 4102444800 {
+		t.Errorf("tokenClaims() exp = %v", exp)
+	}
+}
+
+func TestGetIdentity(t *testing.T) {
+	tests := []struct {
+		name   string
+		claims jwt.MapClaims
+		want   string
+	}{
+		{name: "user", claims: jwt.MapClaims{"email": "user@example.com", "iam_id": "IBMid-1234"}, want: "user@example.com"},
+		{name: "service ID", claims: jwt.MapClaims{"iam_id": "iam-ServiceId-1234", "sub": "ServiceId-1234"}, want: "iam-ServiceId-1234"},
+		{name: "trusted profile", claims: jwt.MapClaims{"sub": "Profile-1234"}, want: "Profile-1234"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.claims["exp"] = 4102444800
+			token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, tt.claims).SignedString([]byte("secret"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			auth, err := core.NewBearerTokenAuthenticator(token)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := GetIdentity(auth)
+			if err != nil {
+				t.Fatalf("GetIdentity() returned error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("GetIdentity() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
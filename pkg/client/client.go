@@ -15,24 +15,25 @@
 package client
 
 import (
-	"context"
 	"fmt"
-	"net/http"
-	"strings"
+	"sync"
 
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
 	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
-	"github.com/golang-jwt/jwt/v5"
-	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
 
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg/audit"
 )
 
 const (
-	serviceInstance = "service_instance"
-	serviceIBMCloud = "IBMCLOUD"
+	serviceInstance   = "service_instance"
+	compositeInstance = "composite_instance"
+	serviceIBMCloud   = "IBMCLOUD"
 )
 
 type Client struct {
@@ -40,26 +41,47 @@ type Client struct {
 	ResourceControllerClient *resourcecontrollerv2.ResourceControllerV2
 	ResourceManagerClient    *resourcemanagerv2.ResourceManagerV2
 	ResourceControllerOpts   *resourcecontrollerv2.ResourceControllerV2Options
+	TaggingClient            *globaltaggingv1.GlobalTaggingV1
+	// Authenticator is shared by all the IBM Cloud, PowerVS and COS clients
+	Authenticator core.Authenticator
+	// Endpoints of the environment the client is created for
+	Endpoints map[string]string
+
+	sessionsMu sync.Mutex
+	// sessions are the PowerVS sessions shared by the workspaces in the zone
+	sessions map[string]*ibmpisession.IBMPISession
 }
 
 type User struct {
+	// ID is the IAM identity of the caller
+	ID      string
 	Account string
 }
 
 func NewClient(apikey string, ep map[string]string, debug bool) (*Client, error) {
-	c := &Client{}
-	auth, err := GetAuthenticator()
+	auth, err := GetAuthenticator(ep[TPEndpoint])
 	if err != nil {
 		return nil, err
 	}
+	c := &Client{Authenticator: auth, Endpoints: ep}
 	accId, err := GetAccountID(auth)
 	if err != nil {
 		return nil, err
 	}
+	if account := SelectedAccount(); account != "" && account != accId {
+		return nil, fmt.Errorf("the credentials are bound to the account %s and can't be used for the account %s, only the API key can switch the accounts", accId, account)
+	}
+
+	id, err := GetIdentity(auth)
+	if err != nil {
+		klog.Warningf("the audit log will miss the identity of the caller, err: %v", err)
+	}
 
 	c.User = &User{
+		ID:      id,
 		Account: accId,
 	}
+	audit.SetIdentity(id, accId)
 
 	resourceControllerOptions := &resourcecontrollerv2.ResourceControllerV2Options{
 		URL:           ep[RCEndpoint],
@@ -69,7 +91,17 @@ func NewClient(apikey string, ep map[string]string, debug bool) (*Client, error)
 	if err != nil {
 		return nil, err
 	}
-	c.ResourceManagerClient, err = resourcemanagerv2.NewResourceManagerV2(&resourcemanagerv2.ResourceManagerV2Options{Authenticator: auth})
+	c.ResourceManagerClient, err = resourcemanagerv2.NewResourceManagerV2(&resourcemanagerv2.ResourceManagerV2Options{
+		URL:           ep[RMEndpoint],
+		Authenticator: auth,
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.TaggingClient, err = globaltaggingv1.NewGlobalTaggingV1(&globaltaggingv1.GlobalTaggingV1Options{
+		URL:           ep[GTEndpoint],
+		Authenticator: auth,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -99,22 +131,30 @@ func (c *Client) ListServiceInstances(resourceId string) (map[string]string, err
 
 // ListWorkspaceInstances is used to retrieve serviceInstances along with their regions.
 func (c *Client) ListWorkspaceInstances() (*resourcecontrollerv2.ResourceInstancesList, error) {
-
-	listServiceInstanceOptions := &resourcecontrollerv2.ListResourceInstancesOptions{
-		Type:           ptr.To(serviceInstance),
-		ResourceID:     ptr.To(utils.PowerVSResourceID),
-		ResourcePlanID: ptr.To(utils.PowerVSResourcePlanID),
-	}
-	workspaces, _, err := c.ResourceControllerClient.ListResourceInstances(listServiceInstanceOptions)
+	resources, err := c.listWorkspaces(&resourcecontrollerv2.ListResourceInstancesOptions{})
 	if err != nil {
-		klog.Errorf("error while listing resource instances: %+v", err)
 		return nil, err
 	}
-	return workspaces, nil
+	return &resourcecontrollerv2.ResourceInstancesList{Resources: resources, RowsCount: ptr.To(int64(len(resources)))}, nil
+}
 
+// ListResourceGroups returns the names of the resource groups in the account keyed by the ID
+func (c *Client) ListResourceGroups() (map[string]string, error) {
+	groups, _, err := c.ResourceManagerClient.ListResourceGroups(&resourcemanagerv2.ListResourceGroupsOptions{
+		AccountID: ptr.To(c.User.Account),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the resource groups: %v", err)
+	}
+	names := map[string]string{}
+	for _, g := range groups.Resources {
+		names[*g.ID] = *g.Name
+	}
+	return names, nil
 }
 
-func (c *Client) CreateServiceInstance(instanceName, serviceName, resourcePlanID, resourceGrp, region string) (*resourcecontrollerv2.ResourceInstance, error) {
+// CreateServiceInstance creates the service instance on the IBM Cloud, the tags are attached to the instance as the user tags
+func (c *Client) CreateServiceInstance(instanceName, serviceName, resourcePlanID, resourceGrp, region string, tags ...string) (*resourcecontrollerv2.ResourceInstance, error) {
 	rmv2ListResourceGroupOpt := &resourcemanagerv2.ListResourceGroupsOptions{
 		Name: &resourceGrp,
 	}
@@ -136,6 +176,7 @@ func (c *Client) CreateServiceInstance(instanceName, serviceName, resourcePlanID
 		ResourcePlanID: ptr.To(resourcePlanID),
 		ResourceGroup:  resourceGroup.ID,
 		Target:         ptr.To(region),
+		Tags:           tags,
 	}
 	resp, _, err := c.ResourceControllerClient.CreateResourceInstance(createServiceInstanceOpts)
 	if err != nil {
@@ -146,6 +187,17 @@ func (c *Client) CreateServiceInstance(instanceName, serviceName, resourcePlanID
 	return resp, nil
 }
 
+// GetServiceInstance returns the service instance on the IBM Cloud by the ID, GUID or the CRN
+func (c *Client) GetServiceInstance(id string) (*resourcecontrollerv2.ResourceInstance, error) {
+	instance, _, err := c.ResourceControllerClient.GetResourceInstance(&resourcecontrollerv2.GetResourceInstanceOptions{
+		ID: ptr.To(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the resource instance %s: %v", id, err)
+	}
+	return instance, nil
+}
+
 // DeleteServiceInstance deletes service instances on the IBM Cloud, takes instanceID as input
 func (c *Client) DeleteServiceInstance(instanceID string, recursive bool) error {
 	deleteServiceInstanceOpts := &resourcecontrollerv2.DeleteResourceInstanceOptions{
@@ -158,41 +210,3 @@ func (c *Client) DeleteServiceInstance(instanceID string, recursive bool) error
 	}
 	return nil
 }
-
-func GetAccountID(auth core.Authenticator) (string, error) {
-	// fake request to get a bearer token from the request header
-	ctx := context.TODO()
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://example.com", http.NoBody)
-	if err != nil {
-		return "", err
-	}
-	err = auth.Authenticate(req)
-	if err != nil {
-		return "", err
-	}
-
-	bearerToken := req.Header.Get("Authorization")
-	if strings.HasPrefix(bearerToken, "Bearer") {
-		bearerToken = bearerToken[7:]
-	}
-	token, err := jwt.Parse(bearerToken, func(_ *jwt.Token) (interface{}, error) {
-		return "", nil
-	})
-
-	if err != nil && !strings.Contains(err.Error(), "key is of invalid type") {
-		return "", err
-	}
-
-	return token.Claims.(jwt.MapClaims)["account"].(map[string]interface{})["bss"].(string), nil
-}
-
-func GetAuthenticator() (core.Authenticator, error) {
-	auth, err := core.GetAuthenticatorFromEnvironment(serviceIBMCloud)
-	if err != nil {
-		return nil, err
-	}
-	if auth == nil {
-		return nil, fmt.Errorf("authenticator can't be nil, please set proper authentication")
-	}
-	return auth, nil
-}
@@ -16,9 +16,18 @@ package client
 
 import (
 	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
 
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/config"
 )
 
 const (
@@ -26,35 +35,151 @@ const (
 	TPEndpoint     = "TPEndpoint"
 	PIEndpoint     = "PIEndpoint"
 	RCEndpoint     = "RCEndpoint"
+	RMEndpoint     = "RMEndpoint"
+	GTEndpoint     = "GTEndpoint"
+	COSEndpoint    = "COSEndpoint"
+	ACEndpoint     = "ACEndpoint"
 )
 
+// privatePrefix is prepended to the hosts of the endpoints to reach them from the IBM Cloud private network
+const privatePrefix = "private."
+
 var ErrEnvironmentNotFound = errors.New("error environment not found")
 
 var Environments = map[string]map[string]string{
 	"test": {
-		TPEndpoint: "https://iam.test.cloud.ibm.com",
-		RCEndpoint: "https://resource-controller.test.cloud.ibm.com",
-		PIEndpoint: "power-iaas.test.cloud.ibm.com",
+		TPEndpoint:  "https://iam.test.cloud.ibm.com",
+		RCEndpoint:  "https://resource-controller.test.cloud.ibm.com",
+		RMEndpoint:  "https://resource-controller.test.cloud.ibm.com",
+		PIEndpoint:  "power-iaas.test.cloud.ibm.com",
+		GTEndpoint:  "https://tags.global-search-tagging.test.cloud.ibm.com",
+		COSEndpoint: "https://s3.{region}.cloud-object-storage.appdomain.cloud",
+		ACEndpoint:  "https://accounts.test.cloud.ibm.com",
 	},
 	"prod": {
-		TPEndpoint: iamidentityv1.DefaultServiceURL,
-		RCEndpoint: resourcecontrollerv2.DefaultServiceURL,
-		PIEndpoint: "power-iaas.cloud.ibm.com",
+		TPEndpoint:  iamidentityv1.DefaultServiceURL,
+		RCEndpoint:  resourcecontrollerv2.DefaultServiceURL,
+		RMEndpoint:  resourcemanagerv2.DefaultServiceURL,
+		PIEndpoint:  "power-iaas.cloud.ibm.com",
+		GTEndpoint:  globaltaggingv1.DefaultServiceURL,
+		COSEndpoint: "https://s3.{region}.cloud-object-storage.appdomain.cloud",
+		ACEndpoint:  "https://accounts.cloud.ibm.com",
 	},
 }
 
+// loadEnvironments returns the built-in environments along with the ones defined in the config file
+func loadEnvironments() (map[string]map[string]string, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+	envs := make(map[string]map[string]string, len(Environments)+len(cfg.Environments))
+	for name, ep := range Environments {
+		envs[name] = ep
+	}
+	for name, e := range cfg.Environments {
+		ep := map[string]string{}
+		for k, v := range Environments[DefaultEnvProd] {
+			ep[k] = v
+		}
+		for k, v := range map[string]string{
+			TPEndpoint:  e.IAM,
+			RCEndpoint:  e.ResourceController,
+			RMEndpoint:  e.ResourceManager,
+			PIEndpoint:  e.PowerVS,
+			GTEndpoint:  e.GlobalTagging,
+			COSEndpoint: e.COS,
+			ACEndpoint:  e.Accounts,
+		} {
+			if v != "" {
+				ep[k] = v
+			}
+		}
+		envs[name] = ep
+	}
+	return envs, nil
+}
+
+// ListEnvironments returns the sorted names of the environments, including the ones defined in the config file
 func ListEnvironments() (keys []string) {
-	for k := range Environments {
+	envs, err := loadEnvironments()
+	if err != nil {
+		envs = Environments
+	}
+	for k := range envs {
 		keys = append(keys, k)
 	}
+	sort.Strings(keys)
 	return
 }
 
+// GetEnvironment returns the endpoints of the environment, rewritten to the private endpoints with --private-endpoints
 func GetEnvironment(env string) (map[string]string, error) {
-	if _, ok := Environments[env]; !ok {
+	envs, err := loadEnvironments()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the environments, err: %v", err)
+	}
+	ep, ok := envs[env]
+	if !ok {
 		return nil, ErrEnvironmentNotFound
 	}
-	return Environments[env], nil
+	if pkg.Options.PrivateEndpoints {
+		return PrivateEndpoints(ep), nil
+	}
+	return ep, nil
+}
+
+// PrivateEndpoints returns the endpoints reachable from the IBM Cloud private network, e.g: from a VPC
+func PrivateEndpoints(ep map[string]string) map[string]string {
+	private := make(map[string]string, len(ep))
+	for k, v := range ep {
+		private[k] = privateEndpoint(v)
+	}
+	return private
+}
+
+// privateEndpoint prepends private. to the host, the hosts of COS and global tagging take it after the first label,
+// e.g: s3.private.<region> and tags.private.global-search-tagging
+func privateEndpoint(endpoint string) string {
+	scheme, host, found := strings.Cut(endpoint, "://")
+	if !found {
+		// endpoints without the scheme, e.g: power-iaas.cloud.ibm.com
+		scheme, host = "", endpoint
+	}
+	label := ""
+	for _, l := range []string{"s3.", "tags."} {
+		if strings.HasPrefix(host, l) {
+			label = l
+		}
+	}
+	if rest := strings.TrimPrefix(host, label); !strings.HasPrefix(rest, privatePrefix) {
+		host = label + privatePrefix + rest
+	}
+	if scheme == "" {
+		return host
+	}
+	return scheme + "://" + host
+}
+
+var zoneSuffix = regexp.MustCompile(`-?[0-9]+$`)
+
+// PowerVSURL returns the PowerVS endpoint for the zone. The public endpoint is prefixed with the region by the
+// PowerVS session, the region of the private endpoint is set here, e.g: private.lon.power-iaas.cloud.ibm.com
+func PowerVSURL(ep map[string]string, zone string) string {
+	endpoint := ep[PIEndpoint]
+	if rest, ok := strings.CutPrefix(endpoint, privatePrefix+"power-iaas."); ok {
+		return privatePrefix + zoneSuffix.ReplaceAllString(zone, "") + ".power-iaas." + rest
+	}
+	return endpoint
+}
+
+// COSURL returns the COS endpoint for the region
+func (c *Client) COSURL(region string) string {
+	endpoint := c.Endpoints[COSEndpoint]
+	if endpoint == "" {
+		endpoint = Environments[DefaultEnvProd][COSEndpoint]
+	}
+	return strings.ReplaceAll(endpoint, "{region}", region)
 }
 
 func NewPVMClientWithEnv(c *Client, instanceID, instanceName, env string) (*PVMClient, error) {
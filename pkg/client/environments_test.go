@@ -15,18 +15,33 @@
 package client
 
 import (
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg"
 )
 
+// setConfig points the config file to a temporary one with the content for the duration of the test
+func setConfig(t *testing.T, content string) {
+	file := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(file, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	pkg.Options.ConfigFile = file
+	t.Cleanup(func() { pkg.Options.ConfigFile = "" })
+}
+
 func TestListEnvironments(t *testing.T) {
+	setConfig(t, "")
 	tests := []struct {
 		name     string
 		wantKeys []string
 	}{
 		{
 			"valid environments",
-			[]string{"test", "prod"},
+			[]string{"prod", "test"},
 		},
 	}
 	for _, tt := range tests {
@@ -39,6 +54,7 @@ func TestListEnvironments(t *testing.T) {
 }
 
 func TestGetEnvironment(t *testing.T) {
+	setConfig(t, "")
 	type args struct {
 		env string
 	}
@@ -79,3 +95,67 @@ func TestGetEnvironment(t *testing.T) {
 		})
 	}
 }
+
+func TestGetEnvironmentFromConfig(t *testing.T) {
+	setConfig(t, `environments:
+  staging:
+    iam: https://iam.staging.example.com
+    powerVS: power-iaas.staging.example.com
+`)
+	if got, want := ListEnvironments(), []string{"prod", "staging", "test"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ListEnvironments() = %v, want %v", got, want)
+	}
+	got, err := GetEnvironment("staging")
+	if err != nil {
+		t.Fatalf("GetEnvironment() returned error: %v", err)
+	}
+	want := map[string]string{}
+	for k, v := range Environments[DefaultEnvProd] {
+		want[k] = v
+	}
+	want[TPEndpoint] = "https://iam.staging.example.com"
+	want[PIEndpoint] = "power-iaas.staging.example.com"
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetEnvironment() = %v, want %v", got, want)
+	}
+}
+
+func TestPrivateEndpoints(t *testing.T) {
+	want := map[string]string{
+		TPEndpoint:  "https://private.iam.cloud.ibm.com",
+		RCEndpoint:  "https://private.resource-controller.cloud.ibm.com",
+		RMEndpoint:  "https://private.resource-controller.cloud.ibm.com",
+		PIEndpoint:  "private.power-iaas.cloud.ibm.com",
+		GTEndpoint:  "https://tags.private.global-search-tagging.cloud.ibm.com",
+		COSEndpoint: "https://s3.private.{region}.cloud-object-storage.appdomain.cloud",
+		ACEndpoint:  "https://private.accounts.cloud.ibm.com",
+	}
+	got := PrivateEndpoints(Environments[DefaultEnvProd])
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PrivateEndpoints() = %v, want %v", got, want)
+	}
+	if again := PrivateEndpoints(got); !reflect.DeepEqual(again, want) {
+		t.Errorf("PrivateEndpoints() on the private endpoints = %v, want %v", again, want)
+	}
+}
+
+func TestPowerVSURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		zone     string
+		want     string
+	}{
+		{"public", "power-iaas.cloud.ibm.com", "lon04", "power-iaas.cloud.ibm.com"},
+		{"private datacenter", "private.power-iaas.cloud.ibm.com", "lon04", "private.lon.power-iaas.cloud.ibm.com"},
+		{"private availability zone", "private.power-iaas.cloud.ibm.com", "us-east", "private.us-east.power-iaas.cloud.ibm.com"},
+		{"private with region", "private.lon.power-iaas.cloud.ibm.com", "lon04", "private.lon.power-iaas.cloud.ibm.com"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PowerVSURL(map[string]string{PIEndpoint: tt.endpoint}, tt.zone); got != tt.want {
+				t.Errorf("PowerVSURL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/IBM-Cloud/power-go-client/ibmpisession"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"k8s.io/utils/ptr"
 )
 
 type Client struct {
@@ -80,6 +81,27 @@ func (c *Client) ImportImage(imageName, s3Filename, region, accessKey, secretKey
 	return jobRef, nil
 }
 
+// GetAllStockImages returns the images in the stock catalog of the region
+func (c *Client) GetAllStockImages(includeSAP, includeVTL bool) (*models.Images, error) {
+	return c.client.GetAllStockImages(includeSAP, includeVTL)
+}
+
+// CopyStockImage copies the stock image into the workspace
+func (c *Client) CopyStockImage(id string) (*models.Image, error) {
+	return c.client.Create(&models.CreateImage{ImageID: id, Source: ptr.To("root-project")})
+}
+
+// ExportImage exports the image to the S3 bucket
+func (c *Client) ExportImage(id, bucketName, region, accessKey, secretKey string) (*models.JobReference, error) {
+	body := &models.ExportImage{
+		AccessKey:  &accessKey,
+		SecretKey:  secretKey,
+		BucketName: &bucketName,
+		Region:     region,
+	}
+	return c.client.ExportImage(id, body)
+}
+
 func (c *Client) GetAllPurgeable(before, since time.Duration, expr string) ([]*models.ImageReference, error) {
 	images, err := c.GetAll()
 	if err != nil {
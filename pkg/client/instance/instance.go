@@ -50,6 +50,25 @@ func (c *Client) Delete(id string) error {
 	return c.client.Delete(id)
 }
 
+// GetByNameOrID returns the instance with the name or the ID
+func (c *Client) GetByNameOrID(nameOrID string) (*models.PVMInstanceReference, error) {
+	instances, err := c.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the list of instances: %v", err)
+	}
+	for _, ins := range instances.PvmInstances {
+		if *ins.ServerName == nameOrID || *ins.PvmInstanceID == nameOrID {
+			return ins, nil
+		}
+	}
+	return nil, fmt.Errorf("instance %s not found", nameOrID)
+}
+
+// Capture captures the instance to the image catalog and/or the cloud storage
+func (c *Client) Capture(id string, body models.PVMInstanceCapture) (*models.JobReference, error) {
+	return c.client.CaptureInstanceToImageCatalogV2(id, &body)
+}
+
 func (c *Client) GetAllPurgeable(before, since time.Duration, expr string) ([]*models.PVMInstanceReference, error) {
 	instances, err := c.GetAll()
 	if err != nil {
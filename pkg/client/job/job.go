@@ -16,10 +16,20 @@ package job
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/ibmpisession"
 	"github.com/IBM-Cloud/power-go-client/power/models"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+const (
+	StateCompleted = "completed"
+	StateFailed    = "failed"
 )
 
 type Client struct {
@@ -47,3 +57,29 @@ func (c *Client) GetAll() (*models.Jobs, error) {
 func (c *Client) Delete(id string) error {
 	return c.client.Delete(id)
 }
+
+// WaitForCompletion polls the job at the pollInterval with the spinner until it is completed, the operation is used
+// in the progress and the error messages, e.g: image import
+func (c *Client) WaitForCompletion(id, operation string, pollInterval, timeout time.Duration) error {
+	return utils.SpinnerPollUntil(time.Tick(pollInterval), time.After(timeout), func() (string, bool, error) {
+		job, err := c.Get(id)
+		if err != nil {
+			return "", false, fmt.Errorf("%s job failed to complete, err: %v", operation, err)
+		}
+		if *job.Status.State == StateCompleted {
+			return "", true, nil
+		}
+		if *job.Status.State == StateFailed {
+			return "", false, fmt.Errorf("%s job failed to complete, err: %v", operation, job.Status.Message)
+		}
+		message := fmt.Sprintf("%s is in-progress, current state: %s", capitalize(operation), *job.Status.State)
+		return message, false, nil
+	})
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
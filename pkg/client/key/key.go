@@ -45,6 +45,10 @@ func (c *Client) Get(id string) (*models.SSHKey, error) {
 	return c.client.Get(id)
 }
 
+func (c *Client) GetAll() (*models.SSHKeys, error) {
+	return c.client.GetAll()
+}
+
 func (c *Client) Create(body *models.SSHKey) (*models.SSHKey, error) {
 	return c.client.Create(body)
 }
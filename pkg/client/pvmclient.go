@@ -18,7 +18,6 @@ import (
 	"fmt"
 
 	"github.com/IBM-Cloud/power-go-client/ibmpisession"
-	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"k8s.io/utils/ptr"
 
@@ -58,53 +57,22 @@ type PVMClient struct {
 }
 
 func NewPVMClient(c *Client, instanceID, instanceName string, ep map[string]string) (*PVMClient, error) {
-	listServiceInstanceOptions := &resourcecontrollerv2.ListResourceInstancesOptions{
-		// TODO: possibility of workspaces to either be of type service_instance or composite_instance.
-		// Type: ptr.To(serviceInstance),
-		Name: ptr.To(instanceName),
-		GUID: ptr.To(instanceID),
-	}
-
-	workspaces, _, err := c.ResourceControllerClient.ListResourceInstances(listServiceInstanceOptions)
+	workspace, err := c.ResolveWorkspace(instanceID, instanceName, pkg.Options.Zone)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list the resource instances: %v", err)
-	}
-	instanceIDorName := instanceID
-	if instanceIDorName == "" {
-		instanceIDorName = instanceName
-	}
-	if len(workspaces.Resources) == 0 {
-		return nil, fmt.Errorf("no resource instances are available to be listed for ID/Name: %s", instanceIDorName)
+		return nil, err
 	}
 	pvmclient := &PVMClient{
-		InstanceName: *workspaces.Resources[0].Name,
-		InstanceID:   *workspaces.Resources[0].GUID,
-		Zone:         *workspaces.Resources[0].RegionID,
+		InstanceName: *workspace.Name,
+		InstanceID:   *workspace.GUID,
+		Zone:         *workspace.RegionID,
 	}
 
-	pvmclientOptions := ibmpisession.IBMPIOptions{
-		Authenticator: &core.IamAuthenticator{ApiKey: pkg.Options.APIKey},
-		Debug:         pkg.Options.Debug,
-		UserAccount:   c.User.Account,
-		URL:           ep[PIEndpoint],
-		Zone:          pvmclient.Zone,
-	}
-
-	pvmclient.PISession, err = ibmpisession.NewIBMPISession(&pvmclientOptions)
+	pvmclient.PISession, err = c.piSession(pvmclient.Zone, ep)
 	if err != nil {
 		return nil, err
 	}
 
-	pvmclient.DatacenterClient = datacenter.NewClient(pvmclient.PISession, pvmclient.InstanceID)
-	pvmclient.DHCPClient = dhcp.NewClient(pvmclient.PISession, pvmclient.InstanceID)
-	pvmclient.EventsClient = events.NewClient(pvmclient.PISession, pvmclient.InstanceID)
-	pvmclient.ImgClient = image.NewClient(pvmclient.PISession, pvmclient.InstanceID)
-	pvmclient.InstanceClient = instance.NewClient(pvmclient.PISession, pvmclient.InstanceID)
-	pvmclient.JobClient = job.NewClient(pvmclient.PISession, pvmclient.InstanceID)
-	pvmclient.KeyClient = key.NewClient(pvmclient.PISession, pvmclient.InstanceID)
-	pvmclient.NetworkClient = network.NewClient(pvmclient.PISession, pvmclient.InstanceID)
-	pvmclient.StorageTierClient = storagetier.NewClient(pvmclient.PISession, pvmclient.InstanceID)
-	pvmclient.VolumeClient = volume.NewClient(pvmclient.PISession, pvmclient.InstanceID)
+	pvmclient.initClients()
 	return pvmclient, nil
 }
 
@@ -119,7 +87,61 @@ func NewGenericPVMClient(c *Client, instanceID string, session *ibmpisession.IBM
 		return nil, fmt.Errorf("failed to list the resource instances: %v", err)
 	}
 
-	pvmclient := &PVMClient{InstanceID: instanceID, InstanceName: *workspace.Name, Zone: *workspace.RegionID, PISession: session}
-	pvmclient.CloudConnectionClient = cloudconnection.NewClient(pvmclient.PISession, instanceID)
-	return pvmclient, nil
+	return NewWorkspacePVMClient(workspace, session), nil
+}
+
+// NewWorkspacePVMClient returns the PVMClient for the listed workspace, the session is obtained with Client.PISession
+// for the zone of the workspace
+func NewWorkspacePVMClient(workspace *resourcecontrollerv2.ResourceInstance, session *ibmpisession.IBMPISession) *PVMClient {
+	pvmclient := &PVMClient{InstanceID: *workspace.GUID, InstanceName: *workspace.Name, Zone: *workspace.RegionID, PISession: session}
+	pvmclient.initClients()
+	return pvmclient
+}
+
+// PISession returns the PowerVS session for the zone, the session is created once and shared by all the workspaces
+// in the zone
+func (c *Client) PISession(zone string) (*ibmpisession.IBMPISession, error) {
+	return c.piSession(zone, c.Endpoints)
+}
+
+func (c *Client) piSession(zone string, ep map[string]string) (*ibmpisession.IBMPISession, error) {
+	c.sessionsMu.Lock()
+	defer c.sessionsMu.Unlock()
+	if s, ok := c.sessions[zone]; ok {
+		return s, nil
+	}
+	s, err := NewPISession(c, zone, ep)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the session for the zone %s, err: %v", zone, err)
+	}
+	if c.sessions == nil {
+		c.sessions = map[string]*ibmpisession.IBMPISession{}
+	}
+	c.sessions[zone] = s
+	return s, nil
+}
+
+// NewPISession returns the PowerVS session for the zone, which can be shared by all the workspaces in the zone
+func NewPISession(c *Client, zone string, ep map[string]string) (*ibmpisession.IBMPISession, error) {
+	return ibmpisession.NewIBMPISession(&ibmpisession.IBMPIOptions{
+		Authenticator: c.Authenticator,
+		Debug:         pkg.Options.Debug,
+		URL:           PowerVSURL(ep, zone),
+		UserAccount:   c.User.Account,
+		Zone:          zone,
+	})
+}
+
+func (pvmclient *PVMClient) initClients() {
+	pvmclient.CloudConnectionClient = cloudconnection.NewClient(pvmclient.PISession, pvmclient.InstanceID)
+	pvmclient.DatacenterClient = datacenter.NewClient(pvmclient.PISession, pvmclient.InstanceID)
+	pvmclient.DHCPClient = dhcp.NewClient(pvmclient.PISession, pvmclient.InstanceID)
+	pvmclient.EventsClient = events.NewClient(pvmclient.PISession, pvmclient.InstanceID)
+	pvmclient.ImgClient = image.NewClient(pvmclient.PISession, pvmclient.InstanceID)
+	pvmclient.InstanceClient = instance.NewClient(pvmclient.PISession, pvmclient.InstanceID)
+	pvmclient.JobClient = job.NewClient(pvmclient.PISession, pvmclient.InstanceID)
+	pvmclient.KeyClient = key.NewClient(pvmclient.PISession, pvmclient.InstanceID)
+	pvmclient.NetworkClient = network.NewClient(pvmclient.PISession, pvmclient.InstanceID)
+	pvmclient.StorageTierClient = storagetier.NewClient(pvmclient.PISession, pvmclient.InstanceID)
+	pvmclient.VolumeClient = volume.NewClient(pvmclient.PISession, pvmclient.InstanceID)
 }
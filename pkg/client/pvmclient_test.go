@@ -0,0 +1,53 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func TestClient_PISession(t *testing.T) {
+	auth, err := core.NewBearerTokenAuthenticator("token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &Client{User: &User{Account: "1234"}, Authenticator: auth, Endpoints: Environments[DefaultEnvProd]}
+
+	var wg sync.WaitGroup
+	sessions := make([]interface{}, 10)
+	for i := range sessions {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			s, err := c.PISession([]string{"lon04", "dal10"}[i%2])
+			if err != nil {
+				t.Error(err)
+			}
+			sessions[i] = s
+		}(i)
+	}
+	wg.Wait()
+	for i := 2; i < len(sessions); i++ {
+		if sessions[i] != sessions[i%2] {
+			t.Errorf("PISession() created more than one session for the zone")
+		}
+	}
+	if sessions[0] == sessions[1] {
+		t.Errorf("PISession() shared the session across the zones")
+	}
+}
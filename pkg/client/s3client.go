@@ -26,7 +26,6 @@ import (
 	"github.com/IBM/ibm-cos-sdk-go/aws"
 	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
 	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
-	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
 	"github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
@@ -34,13 +33,12 @@ import (
 	"github.com/vbauerster/mpb/v8"
 	"github.com/vbauerster/mpb/v8/decor"
 
-	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 )
 
 type S3Client struct {
-	ApiKey       string
 	InstanceName string
 	InstanceID   string
 	Region       string
@@ -74,7 +72,7 @@ func NewS3ClientWithKeys(accesskey, secretkey, region string) (s3client *S3Clien
 
 }
 
-// NewS3Client accepts apikey, instanceid of the IBM COS instance and return the s3 client
+// NewS3Client accepts the client, name of the IBM COS instance and return the s3 client authenticated with the client credentials
 // to perform different s3 operations like upload, delete etc.,
 func NewS3Client(c *Client, instanceName, region string) (s3client *S3Client, err error) {
 	s3client = &S3Client{}
@@ -102,18 +100,12 @@ func NewS3Client(c *Client, instanceName, region string) (s3client *S3Client, er
 		return nil, fmt.Errorf("instance: %s not found", instanceName)
 	}
 
-	if pkg.Options.APIKey == "" {
-		s3client.ApiKey = os.Getenv("IBMCLOUD_APIKEY")
-	} else {
-		s3client.ApiKey = pkg.Options.APIKey
-	}
-
-	s3client.SvcEndpoint = fmt.Sprintf("https://s3.%s.cloud-object-storage.appdomain.cloud", region)
+	s3client.SvcEndpoint = c.COSURL(region)
 	s3client.StorageClass = fmt.Sprintf("%s-standard", region)
 	conf := aws.NewConfig().
 		WithRegion(s3client.StorageClass).
 		WithEndpoint(s3client.SvcEndpoint).
-		WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig(), AuthEndpoint, s3client.ApiKey, s3client.InstanceID)).
+		WithCredentials(c.cosCredentials(s3client.InstanceID)).
 		WithS3ForcePathStyle(true)
 
 	// Create client connection
@@ -260,7 +252,7 @@ type formattedCounter struct {
 }
 
 func (f *formattedCounter) Decor(stat decor.Statistics) (string, int) {
-	str := fmt.Sprintf("%s/%s", formatBytes(*f.read), formatBytes(f.total))
+	str := fmt.Sprintf("%s/%s", utils.FormatBytes(*f.read), utils.FormatBytes(f.total))
 	return str, len(str)
 }
 
@@ -296,26 +288,6 @@ func (r *CustomReader) ReadAt(p []byte, off int64) (int, error) {
 	return n, nil
 }
 
-// Format the bytes to a human-readable string
-func formatBytes(size int64) string {
-	const (
-		KB = 1024
-		MB = KB * 1024
-		GB = MB * 1024
-	)
-
-	switch {
-	case size >= GB:
-		return fmt.Sprintf("%.2f GB", float64(size)/GB)
-	case size >= MB:
-		return fmt.Sprintf("%.2f MB", float64(size)/MB)
-	case size >= KB:
-		return fmt.Sprintf("%.2f KB", float64(size)/KB)
-	default:
-		return fmt.Sprintf("%d Bytes", size)
-	}
-}
-
 func (r *CustomReader) Seek(offset int64, whence int) (int64, error) {
 	return r.fp.Seek(offset, whence)
 }
@@ -384,3 +356,84 @@ func (c *S3Client) UploadObject(fileName, objectName, bucketName string) error {
 	klog.Infof("Upload completed successfully in %s to location %s", time.Since(startTime).Round(time.Second), result.Location)
 	return nil
 }
+
+type customWriter struct {
+	fp              *os.File
+	written         int64
+	mux             sync.Mutex
+	progresstracker *ProgressTracker
+}
+
+func (w *customWriter) WriteAt(p []byte, off int64) (int, error) {
+	n, err := w.fp.WriteAt(p, off)
+	if err != nil {
+		return n, err
+	}
+	w.mux.Lock()
+	w.written += int64(n)
+	w.progresstracker.counter.read = &w.written
+	w.progresstracker.bar.SetCurrent(w.written)
+	w.mux.Unlock()
+	return n, nil
+}
+
+// DownloadObject downloads the object from S3 bucket into the fileName
+func (c *S3Client) DownloadObject(bucketName, objectName, fileName string) error {
+	klog.Infof("Downloading the object %s from the bucket %s", objectName, bucketName)
+	head, err := c.S3Session.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get the object %s from the bucket %s, err: %v", objectName, bucketName, err)
+	}
+
+	file, err := os.Create(fileName)
+	if err != nil {
+		return fmt.Errorf("err creating file %s, err: %s", fileName, err)
+	}
+	defer file.Close()
+
+	size := aws.Int64Value(head.ContentLength)
+	writer := &customWriter{
+		fp: file,
+	}
+
+	// Initialize progress tracker
+	progressTracker := &ProgressTracker{
+		progress: mpb.New(),
+		counter:  &formattedCounter{read: new(int64), total: size},
+	}
+
+	bar := progressTracker.progress.AddBar(size,
+		mpb.PrependDecorators(
+			decor.Name("Downloading: ", decor.WC{W: 15}),
+			progressTracker.counter,
+		),
+		mpb.AppendDecorators(
+			decor.Percentage(),
+		),
+	)
+	writer.progresstracker = progressTracker
+	writer.progresstracker.bar = bar
+
+	downloader := s3manager.NewDownloaderWithClient(c.S3Session, func(d *s3manager.Downloader) {
+		d.PartSize = 64 * 1024 * 1024
+	})
+
+	startTime := time.Now()
+	_, err = downloader.Download(writer, &s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectName),
+	})
+	if err != nil {
+		bar.Abort(false)
+		progressTracker.progress.Wait()
+		return fmt.Errorf("download failed: %v", err)
+	}
+	bar.SetTotal(-1, true)
+	progressTracker.progress.Wait()
+
+	klog.Infof("Download completed successfully in %s to location %s", time.Since(startTime).Round(time.Second), fileName)
+	return nil
+}
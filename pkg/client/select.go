@@ -0,0 +1,131 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"k8s.io/klog/v2"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+	"github.com/ppc64le-cloud/pvsadm/pkg/config"
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+// EnsureWorkspace makes sure the credentials and the workspace are set, when neither the workspace ID nor
+// the name is passed and pvsadm runs in a terminal, the workspace is picked interactively and remembered
+// for the next selection. Non-interactive sessions keep failing with the missing flags error.
+func EnsureWorkspace(workspaceID, workspaceName *string) error {
+	if err := utils.EnsureCredentialsAreSet(); err != nil {
+		return err
+	}
+	err := utils.EnsurePrerequisitesAreSet(*workspaceID, *workspaceName)
+	if err == nil || !utils.IsInteractive() {
+		return err
+	}
+	c, err := NewClientWithEnv(pkg.Options.APIKey, pkg.Options.Environment, pkg.Options.Debug)
+	if err != nil {
+		return err
+	}
+	ws, err := selectWorkspace(c)
+	if err != nil {
+		return err
+	}
+	klog.Infof("Using the workspace %s(%s) from %s", ws.Name, ws.ID, ws.Zone)
+	*workspaceID = ws.ID
+	return nil
+}
+
+func selectWorkspace(c *Client) (*config.Workspace, error) {
+	workspaces, err := c.ListWorkspaceInstances()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the workspaces, err: %v", err)
+	}
+	if len(workspaces.Resources) == 0 {
+		return nil, fmt.Errorf("no workspaces found in the account, --workspace-id or --workspace-name required")
+	}
+
+	state, err := config.LoadState()
+	if err != nil {
+		klog.Warningf("Ignoring the last selected workspace: %v", err)
+		state = &config.State{}
+	}
+	last := state.LastWorkspace
+	if last == nil {
+		last = &config.Workspace{}
+	}
+
+	zone := pkg.Options.Zone
+	if zones := zoneOptions(workspaces.Resources); zone == "" && len(zones) > 2 {
+		if zone, err = utils.SelectOption("Select the zone:", zones, last.Zone); err != nil {
+			return nil, err
+		}
+	}
+	options := workspaceOptions(workspaces.Resources, zone)
+	if len(options) == 0 {
+		return nil, fmt.Errorf("no workspaces found in the zone %s", zone)
+	}
+	id, err := utils.SelectOption("Select the workspace:", options, last.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ws := range workspaces.Resources {
+		if *ws.GUID == id {
+			state.LastWorkspace = &config.Workspace{ID: *ws.GUID, Name: *ws.Name, Zone: *ws.RegionID}
+			break
+		}
+	}
+	if err := state.Save(); err != nil {
+		klog.Warningf("Failed to remember the selected workspace: %v", err)
+	}
+	return state.LastWorkspace, nil
+}
+
+// zoneOptions returns the zones of the workspaces along with an option to show all of them
+func zoneOptions(workspaces []resourcecontrollerv2.ResourceInstance) []utils.Option {
+	seen := map[string]bool{}
+	var zones []string
+	for _, ws := range workspaces {
+		if zone := *ws.RegionID; !seen[zone] {
+			seen[zone] = true
+			zones = append(zones, zone)
+		}
+	}
+	sort.Strings(zones)
+	options := []utils.Option{{Label: "All zones", Value: ""}}
+	for _, zone := range zones {
+		options = append(options, utils.Option{Label: zone, Value: zone})
+	}
+	return options
+}
+
+// workspaceOptions returns the workspaces in the zone sorted by the name, all of them if the zone is empty
+func workspaceOptions(workspaces []resourcecontrollerv2.ResourceInstance, zone string) []utils.Option {
+	var options []utils.Option
+	for _, ws := range workspaces {
+		if zone != "" && *ws.RegionID != zone {
+			continue
+		}
+		options = append(options, utils.Option{
+			Label: fmt.Sprintf("%s (%s, %s)", *ws.Name, *ws.RegionID, *ws.GUID),
+			Value: *ws.GUID,
+		})
+	}
+	sort.Slice(options, func(i, j int) bool { return options[i].Label < options[j].Label })
+	return options
+}
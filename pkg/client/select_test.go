@@ -0,0 +1,67 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"k8s.io/utils/ptr"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+func Test_workspaceOptions(t *testing.T) {
+	workspaces := []resourcecontrollerv2.ResourceInstance{
+		{Name: ptr.To("ws-b"), GUID: ptr.To("2"), RegionID: ptr.To("lon04")},
+		{Name: ptr.To("ws-a"), GUID: ptr.To("1"), RegionID: ptr.To("dal10")},
+		{Name: ptr.To("ws-c"), GUID: ptr.To("3"), RegionID: ptr.To("lon04")},
+	}
+
+	wantZones := []utils.Option{{Label: "All zones", Value: ""}, {Label: "dal10", Value: "dal10"}, {Label: "lon04", Value: "lon04"}}
+	if got := zoneOptions(workspaces); !reflect.DeepEqual(got, wantZones) {
+		t.Errorf("zoneOptions() = %v, want %v", got, wantZones)
+	}
+
+	tests := []struct {
+		name string
+		zone string
+		want []utils.Option
+	}{
+		{
+			"all zones",
+			"",
+			[]utils.Option{{Label: "ws-a (dal10, 1)", Value: "1"}, {Label: "ws-b (lon04, 2)", Value: "2"}, {Label: "ws-c (lon04, 3)", Value: "3"}},
+		},
+		{
+			"filtered by zone",
+			"lon04",
+			[]utils.Option{{Label: "ws-b (lon04, 2)", Value: "2"}, {Label: "ws-c (lon04, 3)", Value: "3"}},
+		},
+		{
+			"no workspaces in zone",
+			"syd05",
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := workspaceOptions(workspaces, tt.zone); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("workspaceOptions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"fmt"
+
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"k8s.io/utils/ptr"
+)
+
+const userTag = "user"
+
+// ListTags returns the user tags attached to the resource
+func (c *Client) ListTags(crn string) ([]string, error) {
+	list, _, err := c.TaggingClient.ListTags(&globaltaggingv1.ListTagsOptions{
+		TagType:    ptr.To(userTag),
+		AttachedTo: ptr.To(crn),
+		Limit:      ptr.To(int64(1000)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the tags of %s: %v", crn, err)
+	}
+	var tags []string
+	for _, t := range list.Items {
+		tags = append(tags, ptr.Deref(t.Name, ""))
+	}
+	return tags, nil
+}
+
+// AttachTags attaches the user tags to the resource
+func (c *Client) AttachTags(crn string, tags ...string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	results, _, err := c.TaggingClient.AttachTag(&globaltaggingv1.AttachTagOptions{
+		Resources: []globaltaggingv1.Resource{{ResourceID: ptr.To(crn)}},
+		TagNames:  tags,
+		TagType:   ptr.To(userTag),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to attach the tags to %s: %v", crn, err)
+	}
+	return tagResultsError(results, "attach")
+}
+
+// DetachTags detaches the user tags from the resource
+func (c *Client) DetachTags(crn string, tags ...string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	results, _, err := c.TaggingClient.DetachTag(&globaltaggingv1.DetachTagOptions{
+		Resources: []globaltaggingv1.Resource{{ResourceID: ptr.To(crn)}},
+		TagNames:  tags,
+		TagType:   ptr.To(userTag),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to detach the tags from %s: %v", crn, err)
+	}
+	return tagResultsError(results, "detach")
+}
+
+func tagResultsError(results *globaltaggingv1.TagResults, op string) error {
+	if results == nil {
+		return nil
+	}
+	for _, r := range results.Results {
+		if ptr.Deref(r.IsError, false) {
+			return fmt.Errorf("failed to %s the tags for %s", op, ptr.Deref(r.ResourceID, ""))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"k8s.io/klog/v2"
+)
+
+// tokenExpiryWindow is the validity left on the cached token below which a new token is obtained
+const tokenExpiryWindow = 5 * time.Minute
+
+// TokenCacheDir returns the directory of the cached IAM tokens, e.g: ~/.cache/pvsadm/tokens
+func TokenCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "pvsadm", "tokens")
+}
+
+// cachedToken is the content of the token cache file
+type cachedToken struct {
+	AccessToken string    `json:"accessToken"`
+	Expiry      time.Time `json:"expiry"`
+}
+
+// tokenCache is an authenticator reusing the bearer token of the wrapped authenticator across the pvsadm invocations
+// until it expires, the token is stored in a file readable only by the user
+type tokenCache struct {
+	core.Authenticator
+
+	file  string
+	mu    sync.Mutex
+	token cachedToken
+	now   func() time.Time
+}
+
+// newTokenCache returns the token cache of the authenticator, the cache file is named after the fingerprint of the
+// credentials and the IAM endpoint so that the tokens of the different keys and environments aren't mixed up
+func newTokenCache(auth core.Authenticator, dir, credentials, iamURL string) *tokenCache {
+	sum := sha256.Sum256([]byte(credentials + "\n" + iamURL))
+	return &tokenCache{Authenticator: auth, file: filepath.Join(dir, hex.EncodeToString(sum[:])+".json"), now: time.Now}
+}
+
+// Authenticate sets the cached token in the request, a new token is obtained if the cached one is about to expire
+func (t *tokenCache) Authenticate(req *http.Request) error {
+	token, err := t.get()
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+func (t *tokenCache) valid() bool {
+	return t.token.AccessToken != "" && t.now().Add(tokenExpiryWindow).Before(t.token.Expiry)
+}
+
+func (t *tokenCache) get() (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.valid() {
+		return t.token.AccessToken, nil
+	}
+	if data, err := os.ReadFile(t.file); err == nil {
+		if err := json.Unmarshal(data, &t.token); err != nil {
+			klog.V(2).Infof("Ignoring the invalid token cache %s: %v", t.file, err)
+		}
+		if t.valid() {
+			klog.V(2).Infof("Using the cached IAM token from %s", t.file)
+			return t.token.AccessToken, nil
+		}
+	}
+
+	token, err := bearerToken(t.Authenticator)
+	if err != nil {
+		return "", err
+	}
+	claims, err := tokenClaims(token)
+	if err != nil {
+		return "", err
+	}
+	exp, err := claims.GetExpirationTime()
+	if err != nil || exp == nil {
+		return "", fmt.Errorf("expiration is missing in the IAM token")
+	}
+	t.token = cachedToken{AccessToken: token, Expiry: exp.Time}
+	if err := t.save(); err != nil {
+		klog.Warningf("Failed to cache the IAM token: %v", err)
+	}
+	return token, nil
+}
+
+func (t *tokenCache) save() error {
+	if err := os.MkdirAll(filepath.Dir(t.file), 0700); err != nil {
+		return err
+	}
+	data, err := json.Marshal(t.token)
+	if err != nil {
+		return err
+	}
+	// write to a temporary file and rename, the concurrent invocations shouldn't read a partially written token
+	tmp, err := os.CreateTemp(filepath.Dir(t.file), ".token-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), t.file)
+}
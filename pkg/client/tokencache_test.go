@@ -0,0 +1,91 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// countingAuthenticator issues a new token valid for the ttl on every call
+type countingAuthenticator struct {
+	core.NoAuthAuthenticator
+	calls int
+	ttl   time.Duration
+}
+
+func (a *countingAuthenticator) Authenticate(req *http.Request) error {
+	a.calls++
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"exp": time.Now().Add(a.ttl).Unix(),
+		"n":   a.calls,
+	}).SignedString([]byte("secret"))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+func Test_tokenCache(t *testing.T) {
+	dir := t.TempDir()
+	auth := &countingAuthenticator{ttl: time.Hour}
+
+	// every cache instance stands for a separate pvsadm invocation
+	first, err := bearerToken(newTokenCache(auth, dir, "apikey:one", "https://iam.cloud.ibm.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := bearerToken(newTokenCache(auth, dir, "apikey:one", "https://iam.cloud.ibm.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != second || auth.calls != 1 {
+		t.Errorf("token is not reused from the cache, calls: %d", auth.calls)
+	}
+
+	cache := newTokenCache(auth, dir, "apikey:one", "https://iam.cloud.ibm.com")
+	fi, err := os.Stat(cache.file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Mode().Perm() != 0600 {
+		t.Errorf("token cache mode = %v, want 0600", fi.Mode().Perm())
+	}
+
+	if _, err := bearerToken(newTokenCache(auth, dir, "apikey:two", "https://iam.cloud.ibm.com")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := bearerToken(newTokenCache(auth, dir, "apikey:one", "https://iam.test.cloud.ibm.com")); err != nil {
+		t.Fatal(err)
+	}
+	if auth.calls != 3 {
+		t.Errorf("tokens of the different credentials or environments are shared, calls: %d", auth.calls)
+	}
+
+	cache.now = func() time.Time { return time.Now().Add(time.Hour - tokenExpiryWindow/2) }
+	third, err := bearerToken(cache)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if third == first || auth.calls != 4 {
+		t.Errorf("token about to expire is reused, calls: %d", auth.calls)
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+// ResolveWorkspace returns the workspace identified by the CRN or the GUID, or else by the name. The zone narrows down
+// the workspaces sharing the name, an error listing the candidates is returned if the name is still ambiguous.
+func (c *Client) ResolveWorkspace(id, name, zone string) (*resourcecontrollerv2.ResourceInstance, error) {
+	if id == "" && strings.HasPrefix(name, "crn:") {
+		id, name = name, ""
+	}
+	if id != "" {
+		return c.getWorkspace(id, zone)
+	}
+	if name == "" {
+		return nil, fmt.Errorf("--workspace-id or --workspace-name required")
+	}
+	workspaces, err := c.listWorkspaces(&resourcecontrollerv2.ListResourceInstancesOptions{Name: ptr.To(name)})
+	if err != nil {
+		return nil, err
+	}
+	return matchWorkspace(workspaces, name, zone)
+}
+
+// getWorkspace fetches the workspace by the GUID or the CRN, both are accepted by the resource controller
+func (c *Client) getWorkspace(id, zone string) (*resourcecontrollerv2.ResourceInstance, error) {
+	ws, _, err := c.ResourceControllerClient.GetResourceInstance(&resourcecontrollerv2.GetResourceInstanceOptions{ID: ptr.To(id)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the workspace %s, err: %v", id, err)
+	}
+	if !isWorkspace(ws) {
+		return nil, fmt.Errorf("%s is not a PowerVS workspace", id)
+	}
+	if zone != "" && *ws.RegionID != zone {
+		return nil, fmt.Errorf("workspace %s is in the zone %s, not in %s", id, *ws.RegionID, zone)
+	}
+	return ws, nil
+}
+
+// listWorkspaces lists all the pages of the PowerVS workspaces, both the service_instance and the composite_instance types
+func (c *Client) listWorkspaces(opts *resourcecontrollerv2.ListResourceInstancesOptions) ([]resourcecontrollerv2.ResourceInstance, error) {
+	opts.ResourceID = ptr.To(utils.PowerVSResourceID)
+	var workspaces []resourcecontrollerv2.ResourceInstance
+	for {
+		page, _, err := c.ResourceControllerClient.ListResourceInstances(opts)
+		if err != nil {
+			klog.Errorf("error while listing resource instances: %+v", err)
+			return nil, err
+		}
+		for _, ri := range page.Resources {
+			if isWorkspace(&ri) {
+				workspaces = append(workspaces, ri)
+			}
+		}
+		start, err := page.GetNextStart()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the next page of the resource instances: %v", err)
+		}
+		if start == nil {
+			return workspaces, nil
+		}
+		opts.Start = start
+	}
+}
+
+func isWorkspace(ri *resourcecontrollerv2.ResourceInstance) bool {
+	if ri.ResourceID == nil || *ri.ResourceID != utils.PowerVSResourceID {
+		return false
+	}
+	return ri.Type == nil || *ri.Type == serviceInstance || *ri.Type == compositeInstance
+}
+
+// matchWorkspace picks the workspace with the name among the listed ones, optionally in the zone
+func matchWorkspace(workspaces []resourcecontrollerv2.ResourceInstance, name, zone string) (*resourcecontrollerv2.ResourceInstance, error) {
+	var candidates []resourcecontrollerv2.ResourceInstance
+	for _, ws := range workspaces {
+		if *ws.Name == name && (zone == "" || *ws.RegionID == zone) {
+			candidates = append(candidates, ws)
+		}
+	}
+	switch len(candidates) {
+	case 0:
+		if zone != "" {
+			return nil, fmt.Errorf("no workspace named %s found in the zone %s", name, zone)
+		}
+		return nil, fmt.Errorf("no workspace named %s found", name)
+	case 1:
+		return &candidates[0], nil
+	}
+	var list []string
+	for _, ws := range candidates {
+		list = append(list, fmt.Sprintf("%s(%s)", *ws.GUID, *ws.RegionID))
+	}
+	sort.Strings(list)
+	return nil, fmt.Errorf("%d workspaces are named %s: %s, pass --zone or --workspace-id to pick one", len(candidates), name, strings.Join(list, ", "))
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"testing"
+
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"k8s.io/utils/ptr"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg/utils"
+)
+
+func Test_matchWorkspace(t *testing.T) {
+	workspaces := []resourcecontrollerv2.ResourceInstance{
+		{Name: ptr.To("upstream-core"), GUID: ptr.To("1"), RegionID: ptr.To("lon04")},
+		{Name: ptr.To("upstream-core"), GUID: ptr.To("2"), RegionID: ptr.To("dal10")},
+		{Name: ptr.To("upstream-core-ci"), GUID: ptr.To("3"), RegionID: ptr.To("lon04")},
+	}
+	tests := []struct {
+		name    string
+		wsName  string
+		zone    string
+		wantID  string
+		wantErr string
+	}{
+		{"unique name", "upstream-core-ci", "", "3", ""},
+		{"ambiguous name", "upstream-core", "", "", "2 workspaces are named upstream-core: 1(lon04), 2(dal10), pass --zone or --workspace-id to pick one"},
+		{"disambiguated by zone", "upstream-core", "dal10", "2", ""},
+		{"not in zone", "upstream-core-ci", "dal10", "", "no workspace named upstream-core-ci found in the zone dal10"},
+		{"not found", "missing", "", "", "no workspace named missing found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := matchWorkspace(workspaces, tt.wsName, tt.zone)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("matchWorkspace() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("matchWorkspace() returned error: %v", err)
+			}
+			if *got.GUID != tt.wantID {
+				t.Errorf("matchWorkspace() = %s, want %s", *got.GUID, tt.wantID)
+			}
+		})
+	}
+}
+
+func Test_isWorkspace(t *testing.T) {
+	tests := []struct {
+		name string
+		ri   resourcecontrollerv2.ResourceInstance
+		want bool
+	}{
+		{"service instance", resourcecontrollerv2.ResourceInstance{ResourceID: ptr.To(utils.PowerVSResourceID), Type: ptr.To(serviceInstance)}, true},
+		{"composite instance", resourcecontrollerv2.ResourceInstance{ResourceID: ptr.To(utils.PowerVSResourceID), Type: ptr.To(compositeInstance)}, true},
+		{"other service", resourcecontrollerv2.ResourceInstance{ResourceID: ptr.To(utils.CosResourceID), Type: ptr.To(serviceInstance)}, false},
+		{"other type", resourcecontrollerv2.ResourceInstance{ResourceID: ptr.To(utils.PowerVSResourceID), Type: ptr.To("resource_alias")}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isWorkspace(&tt.ri); got != tt.want {
+				t.Errorf("isWorkspace() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"gopkg.in/yaml.v2"
+	yaml3 "gopkg.in/yaml.v3"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+)
+
+// Config holds the settings persisted between the pvsadm invocations
+type Config struct {
+	// Account is the IBM Cloud account the commands work against, overridden by --account-id
+	Account string `yaml:"account,omitempty"`
+	// Environments are the additional IBM Cloud environments selectable via --env
+	Environments map[string]Environment `yaml:"environments,omitempty"`
+	// Audit configures where the audit entries are shipped
+	Audit Audit `yaml:"audit,omitempty"`
+}
+
+// Audit configures the audit sinks, the entries are written to the --audit-file when no sinks are set
+type Audit struct {
+	Sinks []AuditSink `yaml:"sinks,omitempty"`
+}
+
+// AuditSink configures a sink of the audit entries, the fields apply to the sink of the type
+type AuditSink struct {
+	// Type is one of: file, syslog, webhook
+	Type string `yaml:"type"`
+
+	// Path of the file sink, defaults to the --audit-file
+	Path string `yaml:"path,omitempty"`
+	// MaxSize in MiB after which the file is rotated, defaults to the --audit-max-size
+	MaxSize int `yaml:"maxSize,omitempty"`
+
+	// Network of the syslog sink, udp(default) or tcp
+	Network string `yaml:"network,omitempty"`
+	// Address of the syslog server, e.g: logs.example.com:514
+	Address string `yaml:"address,omitempty"`
+	// Facility of the syslog messages, defaults to local0
+	Facility string `yaml:"facility,omitempty"`
+
+	// URL the webhook sink posts the batches of the entries to
+	URL string `yaml:"url,omitempty"`
+	// Headers of the webhook requests, the values are expanded with the environment variables, e.g: Bearer ${AUDIT_TOKEN}
+	Headers map[string]string `yaml:"headers,omitempty"`
+	// BatchSize is the number of the entries posted in a request, defaults to 100
+	BatchSize int `yaml:"batchSize,omitempty"`
+	// FlushInterval after which the pending entries are posted, defaults to 5s
+	FlushInterval time.Duration `yaml:"flushInterval,omitempty"`
+	// MaxRetries of a failed request, defaults to 3
+	MaxRetries int `yaml:"maxRetries,omitempty"`
+}
+
+// Environment holds the service endpoints of an IBM Cloud environment, the unset endpoints default to the prod ones
+type Environment struct {
+	IAM                string `yaml:"iam,omitempty"`
+	ResourceController string `yaml:"resourceController,omitempty"`
+	ResourceManager    string `yaml:"resourceManager,omitempty"`
+	PowerVS            string `yaml:"powerVS,omitempty"`
+	// COS is the endpoint of the Cloud Object Storage, {region} is replaced with the region of the bucket
+	COS           string `yaml:"cos,omitempty"`
+	GlobalTagging string `yaml:"globalTagging,omitempty"`
+	Accounts      string `yaml:"accounts,omitempty"`
+}
+
+// Workspace identifies a PowerVS workspace
+type Workspace struct {
+	ID   string `yaml:"id"`
+	Name string `yaml:"name"`
+	Zone string `yaml:"zone"`
+}
+
+// Path returns the location of the config file, set via --config or defaults to ~/.config/pvsadm/config.yaml
+func Path() (string, error) {
+	if pkg.Options.ConfigFile != "" {
+		return pkg.Options.ConfigFile, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find the user config directory, err: %v", err)
+	}
+	return filepath.Join(dir, "pvsadm", "config.yaml"), nil
+}
+
+// Load reads the config file, an empty config is returned if the file is not present
+func Load() (*Config, error) {
+	file, err := Path()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(file)
+	if os.IsNotExist(err) {
+		return &Config{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the config file %s, err: %v", file, err)
+	}
+	c := &Config{}
+	if err := yaml.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("failed to parse the config file %s, err: %v", file, err)
+	}
+	return c, nil
+}
+
+// Set sets the top level key of the config file to the value, the empty value removes the key. The file is patched
+// in place to keep the comments, the order and the keys unknown to this version of pvsadm.
+func Set(key, value string) error {
+	file, err := Path()
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(file)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read the config file %s, err: %v", file, err)
+	}
+	doc := &yaml3.Node{}
+	if err := yaml3.Unmarshal(data, doc); err != nil {
+		return fmt.Errorf("failed to parse the config file %s, err: %v", file, err)
+	}
+	if len(doc.Content) == 0 {
+		doc = &yaml3.Node{Kind: yaml3.DocumentNode, Content: []*yaml3.Node{{Kind: yaml3.MappingNode, Tag: "!!map"}}}
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml3.MappingNode {
+		return fmt.Errorf("config file %s is not a YAML mapping", file)
+	}
+
+	// The mapping holds the keys and the values in turns
+	i := -1
+	for j := 0; j+1 < len(root.Content); j += 2 {
+		if root.Content[j].Value == key {
+			i = j
+			break
+		}
+	}
+	switch {
+	case i >= 0 && value == "":
+		root.Content = slices.Delete(root.Content, i, i+2)
+	case i >= 0:
+		root.Content[i+1].SetString(value)
+	case value != "":
+		k, v := &yaml3.Node{}, &yaml3.Node{}
+		k.SetString(key)
+		v.SetString(value)
+		root.Content = append(root.Content, k, v)
+	default:
+		return nil
+	}
+
+	var out bytes.Buffer
+	enc := yaml3.NewEncoder(&out)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+	return write(file, out.Bytes())
+}
+
+// write writes the file readable only by the user
+func write(file string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+		return fmt.Errorf("failed to create the directory of the file %s, err: %v", file, err)
+	}
+	if err := os.WriteFile(file, data, 0600); err != nil {
+		return fmt.Errorf("failed to write the file %s, err: %v", file, err)
+	}
+	return nil
+}
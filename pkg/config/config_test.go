@@ -0,0 +1,120 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg"
+)
+
+func TestSet(t *testing.T) {
+	pkg.Options.ConfigFile = filepath.Join(t.TempDir(), "pvsadm", "config.yaml")
+	defer func() { pkg.Options.ConfigFile = "" }()
+
+	if err := Set("account", "1234"); err != nil {
+		t.Fatalf("Set() on a missing file returned error: %v", err)
+	}
+	if c, err := Load(); err != nil || c.Account != "1234" {
+		t.Fatalf("Load() = %+v, %v, want the account set", c, err)
+	}
+
+	config := `# PowerVS settings
+environments:
+  staging:
+    powerVS: power-iaas.staging.example.com # staging API
+account: "1234"
+futureKey: kept
+`
+	if err := os.WriteFile(pkg.Options.ConfigFile, []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := Set("account", "5678"); err != nil {
+		t.Fatalf("Set() returned error: %v", err)
+	}
+	data, err := os.ReadFile(pkg.Options.ConfigFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := strings.Replace(config, `"1234"`, `"5678"`, 1); string(data) != want {
+		t.Errorf("Set() wrote %s, want %s", data, want)
+	}
+
+	if err := Set("account", ""); err != nil {
+		t.Fatalf("Set() returned error: %v", err)
+	}
+	if c, err := Load(); err != nil || c.Account != "" || c.Environments["staging"].PowerVS == "" {
+		t.Errorf("Load() = %+v, %v, want the account removed and the environments kept", c, err)
+	}
+}
+
+func TestLoadSaveState(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	s, err := LoadState()
+	if err != nil {
+		t.Fatalf("LoadState() on a missing file returned error: %v", err)
+	}
+	if s.LastWorkspace != nil {
+		t.Fatalf("LoadState() on a missing file = %+v, want empty state", s)
+	}
+
+	s.LastWorkspace = &Workspace{ID: "1234", Name: "upstream-core", Zone: "lon04"}
+	if err := s.Save(); err != nil {
+		t.Fatalf("Save() returned error: %v", err)
+	}
+	got, err := LoadState()
+	if err != nil {
+		t.Fatalf("LoadState() returned error: %v", err)
+	}
+	if got.LastWorkspace == nil || *got.LastWorkspace != *s.LastWorkspace {
+		t.Errorf("LoadState() = %+v, want %+v", got.LastWorkspace, s.LastWorkspace)
+	}
+}
+
+func TestLoadAuditSinks(t *testing.T) {
+	pkg.Options.ConfigFile = filepath.Join(t.TempDir(), "config.yaml")
+	defer func() { pkg.Options.ConfigFile = "" }()
+
+	data := `audit:
+  sinks:
+  - type: syslog
+    network: tcp
+    address: logs.example.com:514
+  - type: webhook
+    url: https://audit.example.com/events
+    flushInterval: 30s
+`
+	if err := os.WriteFile(pkg.Options.ConfigFile, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+	c, err := Load()
+	if err != nil {
+		t.Fatalf("Load() returned error: %v", err)
+	}
+	if len(c.Audit.Sinks) != 2 {
+		t.Fatalf("Load() = %+v, want 2 audit sinks", c.Audit.Sinks)
+	}
+	if s := c.Audit.Sinks[0]; s.Type != "syslog" || s.Network != "tcp" || s.Address != "logs.example.com:514" {
+		t.Errorf("Load() syslog sink = %+v", s)
+	}
+	if s := c.Audit.Sinks[1]; s.URL != "https://audit.example.com/events" || s.FlushInterval != 30*time.Second {
+		t.Errorf("Load() webhook sink = %+v", s)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+)
+
+// State holds the values pvsadm remembers between the invocations, kept apart from the hand-written config file
+type State struct {
+	// LastWorkspace is the workspace picked last in the interactive selection
+	LastWorkspace *Workspace `yaml:"lastWorkspace,omitempty"`
+}
+
+// StatePath returns the location of the state file, e.g: ~/.cache/pvsadm/state.yaml
+func StatePath() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find the user cache directory, err: %v", err)
+	}
+	return filepath.Join(dir, "pvsadm", "state.yaml"), nil
+}
+
+// LoadState reads the state file, an empty state is returned if the file is not present
+func LoadState() (*State, error) {
+	file, err := StatePath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(file)
+	if os.IsNotExist(err) {
+		return &State{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the state file %s, err: %v", file, err)
+	}
+	s := &State{}
+	if err := yaml.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("failed to parse the state file %s, err: %v", file, err)
+	}
+	return s, nil
+}
+
+// Save writes the state file
+func (s *State) Save() error {
+	file, err := StatePath()
+	if err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return write(file, data)
+}
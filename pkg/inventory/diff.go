@@ -0,0 +1,117 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inventory
+
+import (
+	"slices"
+	"sort"
+)
+
+// Types of the changes between the snapshots
+const (
+	Added   = "added"
+	Removed = "removed"
+	Changed = "changed"
+)
+
+// Change is the difference of the resource between the snapshots
+type Change struct {
+	Type   string        `json:"type"`
+	Kind   string        `json:"kind"`
+	ID     string        `json:"id"`
+	Name   string        `json:"name"`
+	Fields []FieldChange `json:"fields,omitempty"`
+}
+
+// FieldChange is the changed attribute of the resource
+type FieldChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// Diff returns the resources added, removed and changed between the from and the to snapshots, sorted by the kind and
+// the name. The kinds skipped in either of the snapshots are not compared.
+func Diff(from, to *Snapshot) []Change {
+	skipped := map[string]bool{}
+	for _, k := range slices.Concat(from.Skipped, to.Skipped) {
+		skipped[k] = true
+	}
+	before := map[string]Resource{}
+	for _, r := range from.Resources {
+		if !skipped[r.Kind] {
+			before[r.Key()] = r
+		}
+	}
+	after := map[string]Resource{}
+	for _, r := range to.Resources {
+		if !skipped[r.Kind] {
+			after[r.Key()] = r
+		}
+	}
+
+	var changes []Change
+	for key, r := range after {
+		o, ok := before[key]
+		if !ok {
+			changes = append(changes, Change{Type: Added, Kind: r.Kind, ID: r.ID, Name: r.Name})
+			continue
+		}
+		if fields := diffAttributes(o, r); len(fields) > 0 {
+			changes = append(changes, Change{Type: Changed, Kind: r.Kind, ID: r.ID, Name: r.Name, Fields: fields})
+		}
+	}
+	for key, r := range before {
+		if _, ok := after[key]; !ok {
+			changes = append(changes, Change{Type: Removed, Kind: r.Kind, ID: r.ID, Name: r.Name})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		a, b := changes[i], changes[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.ID < b.ID
+	})
+	return changes
+}
+
+func diffAttributes(from, to Resource) []FieldChange {
+	var fields []FieldChange
+	if from.Name != to.Name {
+		fields = append(fields, FieldChange{Field: "name", Old: from.Name, New: to.Name})
+	}
+	keys := map[string]bool{}
+	for k := range from.Attributes {
+		keys[k] = true
+	}
+	for k := range to.Attributes {
+		keys[k] = true
+	}
+	var sorted []string
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+	for _, k := range sorted {
+		if o, n := from.Attributes[k], to.Attributes[k]; o != n {
+			fields = append(fields, FieldChange{Field: k, Old: o, New: n})
+		}
+	}
+	return fields
+}
@@ -0,0 +1,366 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package inventory captures the snapshot of the workspace resources and compares the snapshots
+package inventory
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/go-openapi/strfmt"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg/client"
+)
+
+// Kinds of the resources captured in the snapshot
+const (
+	KindInstance        = "instance"
+	KindVolume          = "volume"
+	KindNetwork         = "network"
+	KindPort            = "port"
+	KindImage           = "image"
+	KindDHCPServer      = "dhcp-server"
+	KindSSHKey          = "ssh-key"
+	KindCloudConnection = "cloud-connection"
+)
+
+// Snapshot is the inventory of the workspace at a point in time
+type Snapshot struct {
+	Workspace   string     `json:"workspace"`
+	WorkspaceID string     `json:"workspaceID"`
+	Zone        string     `json:"zone"`
+	Timestamp   time.Time  `json:"timestamp"`
+	Resources   []Resource `json:"resources"`
+	// Skipped are the kinds of the resources which couldn't be listed, they are left out of the diff
+	Skipped []string `json:"skipped,omitempty"`
+}
+
+// Resource is the workspace resource with the attributes compared by the diff
+type Resource struct {
+	Kind       string            `json:"kind"`
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// Key uniquely identifies the resource in the snapshot
+func (r Resource) Key() string {
+	return r.Kind + "/" + r.ID
+}
+
+// Collect captures the snapshot of all the resources listed by the sub-clients of the pvmclient
+func Collect(pvmclient *client.PVMClient) (*Snapshot, error) {
+	s := &Snapshot{
+		Workspace:   pvmclient.InstanceName,
+		WorkspaceID: pvmclient.InstanceID,
+		Zone:        pvmclient.Zone,
+		Timestamp:   time.Now().UTC(),
+	}
+
+	instances, err := pvmclient.InstanceClient.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the instances, err: %v", err)
+	}
+	for _, i := range instances.PvmInstances {
+		s.add(instanceResource(i))
+	}
+
+	volumes, err := pvmclient.VolumeClient.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the volumes, err: %v", err)
+	}
+	for _, v := range volumes.Volumes {
+		s.add(volumeResource(v))
+	}
+
+	networks, err := pvmclient.NetworkClient.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the networks, err: %v", err)
+	}
+	for _, n := range networks.Networks {
+		id := ptr.Deref(n.NetworkID, "")
+		network, err := pvmclient.NetworkClient.Get(id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the network %s, err: %v", id, err)
+		}
+		s.add(networkResource(network))
+		ports, err := pvmclient.NetworkClient.GetAllPorts(id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the ports of the network %s, err: %v", id, err)
+		}
+		for _, p := range ports.Ports {
+			s.add(portResource(ptr.Deref(n.Name, ""), p))
+		}
+	}
+
+	images, err := pvmclient.ImgClient.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the images, err: %v", err)
+	}
+	for _, i := range images.Images {
+		s.add(imageResource(i))
+	}
+
+	servers, err := pvmclient.DHCPClient.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the dhcp servers, err: %v", err)
+	}
+	for _, d := range servers {
+		s.add(dhcpServerResource(d))
+	}
+
+	keys, err := pvmclient.KeyClient.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the ssh keys, err: %v", err)
+	}
+	for _, k := range keys.SSHKeys {
+		s.add(sshKeyResource(k))
+	}
+
+	connections, ok := cloudConnections(pvmclient)
+	if !ok {
+		s.Skipped = append(s.Skipped, KindCloudConnection)
+	}
+	for _, c := range connections {
+		s.add(cloudConnectionResource(c))
+	}
+
+	s.Sort()
+	return s, nil
+}
+
+// cloudConnections lists the cloud connections of the workspace and reports whether they could be listed. The cloud
+// connections are not supported in the workspaces with the Power Edge Router(PER), the failure is logged and the
+// cloud connections are left out rather than failing the whole listing.
+func cloudConnections(pvmclient *client.PVMClient) ([]*models.CloudConnection, bool) {
+	connections, err := pvmclient.CloudConnectionClient.GetAll()
+	if err != nil {
+		klog.Warningf("Skipping the cloud connections of the workspace %s, err: %v", pvmclient.InstanceName, err)
+		return nil, false
+	}
+	return connections.CloudConnections, true
+}
+
+func (s *Snapshot) add(r Resource) {
+	s.Resources = append(s.Resources, r)
+}
+
+// Sort sorts the resources by the kind, the name and the id
+func (s *Snapshot) Sort() {
+	sort.SliceStable(s.Resources, func(i, j int) bool {
+		a, b := s.Resources[i], s.Resources[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.ID < b.ID
+	})
+}
+
+// Write writes the snapshot as the indented JSON
+func (s *Snapshot) Write(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(s)
+}
+
+// Load reads the snapshot from the file
+func Load(file string) (*Snapshot, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	s := &Snapshot{}
+	if err := json.Unmarshal(content, s); err != nil {
+		return nil, fmt.Errorf("failed to parse the snapshot %s, err: %v", file, err)
+	}
+	return s, nil
+}
+
+func instanceResource(i *models.PVMInstanceReference) Resource {
+	var networks []string
+	for _, n := range i.Networks {
+		networks = append(networks, n.NetworkName+"="+n.IPAddress)
+	}
+	return Resource{
+		Kind: KindInstance,
+		ID:   ptr.Deref(i.PvmInstanceID, ""),
+		Name: ptr.Deref(i.ServerName, ""),
+		Attributes: map[string]string{
+			"status":     ptr.Deref(i.Status, ""),
+			"processors": formatFloat(i.Processors),
+			"procType":   ptr.Deref(i.ProcType, ""),
+			"memory":     formatFloat(i.Memory),
+			"sysType":    i.SysType,
+			"imageID":    ptr.Deref(i.ImageID, ""),
+			"networks":   join(networks),
+			"created":    formatDate(&i.CreationDate),
+		},
+	}
+}
+
+func volumeResource(v *models.VolumeReference) Resource {
+	return Resource{
+		Kind: KindVolume,
+		ID:   ptr.Deref(v.VolumeID, ""),
+		Name: ptr.Deref(v.Name, ""),
+		Attributes: map[string]string{
+			"state":     ptr.Deref(v.State, ""),
+			"size":      formatFloat(v.Size),
+			"diskType":  ptr.Deref(v.DiskType, ""),
+			"bootable":  formatBool(v.Bootable),
+			"shareable": formatBool(v.Shareable),
+			"instances": join(v.PvmInstanceIDs),
+			"created":   formatDate(v.CreationDate),
+		},
+	}
+}
+
+func networkResource(n *models.Network) Resource {
+	return Resource{
+		Kind: KindNetwork,
+		ID:   ptr.Deref(n.NetworkID, ""),
+		Name: ptr.Deref(n.Name, ""),
+		Attributes: map[string]string{
+			"type":    ptr.Deref(n.Type, ""),
+			"cidr":    ptr.Deref(n.Cidr, ""),
+			"gateway": n.Gateway,
+			"vlanID":  formatFloat(n.VlanID),
+		},
+	}
+}
+
+func portResource(network string, p *models.NetworkPort) Resource {
+	var instance string
+	if p.PvmInstance != nil {
+		instance = p.PvmInstance.PvmInstanceID
+	}
+	return Resource{
+		Kind: KindPort,
+		ID:   ptr.Deref(p.PortID, ""),
+		Name: ptr.Deref(p.IPAddress, ""),
+		Attributes: map[string]string{
+			"network":    network,
+			"status":     ptr.Deref(p.Status, ""),
+			"macAddress": ptr.Deref(p.MacAddress, ""),
+			"externalIP": p.ExternalIP,
+			"instance":   instance,
+		},
+	}
+}
+
+func imageResource(i *models.ImageReference) Resource {
+	return Resource{
+		Kind: KindImage,
+		ID:   ptr.Deref(i.ImageID, ""),
+		Name: ptr.Deref(i.Name, ""),
+		Attributes: map[string]string{
+			"state":       ptr.Deref(i.State, ""),
+			"storageType": ptr.Deref(i.StorageType, ""),
+			"storagePool": ptr.Deref(i.StoragePool, ""),
+			"created":     formatDate(i.CreationDate),
+		},
+	}
+}
+
+func dhcpServerResource(d *models.DHCPServer) Resource {
+	var network string
+	if d.Network != nil {
+		network = ptr.Deref(d.Network.Name, "")
+	}
+	return Resource{
+		Kind: KindDHCPServer,
+		ID:   ptr.Deref(d.ID, ""),
+		Name: network,
+		Attributes: map[string]string{
+			"status":  ptr.Deref(d.Status, ""),
+			"network": network,
+		},
+	}
+}
+
+func sshKeyResource(k *models.SSHKey) Resource {
+	name := ptr.Deref(k.Name, "")
+	return Resource{
+		Kind: KindSSHKey,
+		ID:   name,
+		Name: name,
+		Attributes: map[string]string{
+			"sshKey":  ptr.Deref(k.SSHKey, ""),
+			"created": formatDate(k.CreationDate),
+		},
+	}
+}
+
+func cloudConnectionResource(c *models.CloudConnection) Resource {
+	var networks []string
+	for _, n := range c.Networks {
+		networks = append(networks, ptr.Deref(n.Name, ""))
+	}
+	var speed string
+	if c.Speed != nil {
+		speed = strconv.FormatInt(*c.Speed, 10)
+	}
+	return Resource{
+		Kind: KindCloudConnection,
+		ID:   ptr.Deref(c.CloudConnectionID, ""),
+		Name: ptr.Deref(c.Name, ""),
+		Attributes: map[string]string{
+			"linkStatus":    ptr.Deref(c.LinkStatus, ""),
+			"speed":         speed,
+			"globalRouting": formatBool(c.GlobalRouting),
+			"networks":      join(networks),
+		},
+	}
+}
+
+func formatFloat(f *float64) string {
+	if f == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*f, 'f', -1, 64)
+}
+
+func formatBool(b *bool) string {
+	if b == nil {
+		return ""
+	}
+	return strconv.FormatBool(*b)
+}
+
+func formatDate(d *strfmt.DateTime) string {
+	if d == nil || time.Time(*d).IsZero() {
+		return ""
+	}
+	return time.Time(*d).UTC().Format(time.RFC3339)
+}
+
+// join joins the sorted values, the order of the lists returned by the APIs is not stable
+func join(values []string) string {
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ",")
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inventory
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"k8s.io/utils/ptr"
+)
+
+func TestDiff(t *testing.T) {
+	from := &Snapshot{Resources: []Resource{
+		{Kind: KindInstance, ID: "vm-1", Name: "vm-1", Attributes: map[string]string{"status": "ACTIVE"}},
+		{Kind: KindInstance, ID: "vm-2", Name: "vm-2", Attributes: map[string]string{"status": "ACTIVE"}},
+		{Kind: KindVolume, ID: "vol-1", Name: "data", Attributes: map[string]string{"size": "10"}},
+	}}
+	to := &Snapshot{Resources: []Resource{
+		{Kind: KindInstance, ID: "vm-1", Name: "vm-1", Attributes: map[string]string{"status": "ACTIVE"}},
+		{Kind: KindInstance, ID: "vm-3", Name: "vm-3", Attributes: map[string]string{"status": "BUILD"}},
+		{Kind: KindVolume, ID: "vol-1", Name: "data-renamed", Attributes: map[string]string{"size": "20", "state": "in-use"}},
+	}}
+	want := []Change{
+		{Type: Removed, Kind: KindInstance, ID: "vm-2", Name: "vm-2"},
+		{Type: Added, Kind: KindInstance, ID: "vm-3", Name: "vm-3"},
+		{Type: Changed, Kind: KindVolume, ID: "vol-1", Name: "data-renamed", Fields: []FieldChange{
+			{Field: "name", Old: "data", New: "data-renamed"},
+			{Field: "size", Old: "10", New: "20"},
+			{Field: "state", Old: "", New: "in-use"},
+		}},
+	}
+	if got := Diff(from, to); !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff() = %+v, want %+v", got, want)
+	}
+	if got := Diff(to, to); len(got) != 0 {
+		t.Errorf("Diff() of the same snapshot = %+v, want no changes", got)
+	}
+}
+
+func TestWriteLoad(t *testing.T) {
+	s := &Snapshot{Workspace: "ws", WorkspaceID: "ws-id"}
+	s.add(volumeResource(&models.VolumeReference{
+		VolumeID: ptr.To("vol-1"), Name: ptr.To("data"), State: ptr.To("in-use"), Size: ptr.To(10.0),
+		PvmInstanceIDs: []string{"vm-2", "vm-1"},
+	}))
+	s.add(instanceResource(&models.PVMInstanceReference{PvmInstanceID: ptr.To("vm-1"), ServerName: ptr.To("a"), Status: ptr.To("ACTIVE")}))
+	s.Sort()
+	if s.Resources[0].Kind != KindInstance {
+		t.Errorf("Sort() first resource = %s, want %s", s.Resources[0].Kind, KindInstance)
+	}
+	if got := s.Resources[1].Attributes["instances"]; got != "vm-1,vm-2" {
+		t.Errorf("volume instances = %s, want vm-1,vm-2", got)
+	}
+
+	var b bytes.Buffer
+	if err := s.Write(&b); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	file := filepath.Join(t.TempDir(), "snap.json")
+	if err := os.WriteFile(file, b.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := Load(file)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if changes := Diff(s, loaded); len(changes) != 0 {
+		t.Errorf("Diff() of the loaded snapshot = %+v, want no changes", changes)
+	}
+}
+
+func TestDiffSkipped(t *testing.T) {
+	from := &Snapshot{Resources: []Resource{
+		{Kind: KindInstance, ID: "vm-1", Name: "vm-1"},
+		{Kind: KindCloudConnection, ID: "cc-1", Name: "cc"},
+	}}
+	to := &Snapshot{Resources: []Resource{
+		{Kind: KindInstance, ID: "vm-1", Name: "vm-1"},
+		{Kind: KindInstance, ID: "vm-2", Name: "vm-2"},
+	}, Skipped: []string{KindCloudConnection}}
+	want := []Change{{Type: Added, Kind: KindInstance, ID: "vm-2", Name: "vm-2"}}
+	if got := Diff(from, to); !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff() = %+v, want %+v", got, want)
+	}
+}
+
+func TestCollectSkipsCloudConnections(t *testing.T) {
+	responses := workspaceResponses()
+	delete(responses, "/cloud-connections")
+	responses["/sshkeys"] = `{"sshKeys": []}`
+
+	s, err := Collect(fakeWorkspace(t, responses))
+	if err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	if want := []string{KindCloudConnection}; !reflect.DeepEqual(s.Skipped, want) {
+		t.Errorf("Collect() skipped = %v, want %v", s.Skipped, want)
+	}
+	if got := len(s.Resources); got != 2 {
+		t.Errorf("Collect() resources = %+v, want the instance and the dhcp server", s.Resources)
+	}
+}
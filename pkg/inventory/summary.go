@@ -0,0 +1,72 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inventory
+
+import (
+	"fmt"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg/client"
+)
+
+// Count is the number of the resources of the kind
+type Count struct {
+	Kind  string
+	Count int
+}
+
+// Summarize counts the resources contained in the workspace, it is cheaper than the Collect as the networks and the
+// ports are not fetched one by one
+func Summarize(pvmclient *client.PVMClient) ([]Count, error) {
+	instances, err := pvmclient.InstanceClient.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the instances, err: %v", err)
+	}
+	volumes, err := pvmclient.VolumeClient.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the volumes, err: %v", err)
+	}
+	networks, err := pvmclient.NetworkClient.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the networks, err: %v", err)
+	}
+	images, err := pvmclient.ImgClient.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the images, err: %v", err)
+	}
+	servers, err := pvmclient.DHCPClient.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the dhcp servers, err: %v", err)
+	}
+	counts := []Count{
+		{Kind: KindInstance, Count: len(instances.PvmInstances)},
+		{Kind: KindVolume, Count: len(volumes.Volumes)},
+		{Kind: KindNetwork, Count: len(networks.Networks)},
+		{Kind: KindImage, Count: len(images.Images)},
+		{Kind: KindDHCPServer, Count: len(servers)},
+	}
+	if connections, ok := cloudConnections(pvmclient); ok {
+		counts = append(counts, Count{Kind: KindCloudConnection, Count: len(connections)})
+	}
+	return counts, nil
+}
+
+// Total returns the total number of the resources
+func Total(counts []Count) int {
+	total := 0
+	for _, c := range counts {
+		total += c.Count
+	}
+	return total
+}
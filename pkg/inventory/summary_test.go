@@ -0,0 +1,126 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inventory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"k8s.io/utils/ptr"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg/client"
+)
+
+// fakeWorkspace returns the PVMClient of a workspace served by the fake PowerVS API, the responses are keyed by the
+// suffix of the request path and the paths missing in the responses fail with the internal server error
+func fakeWorkspace(t *testing.T, responses map[string]string) *client.PVMClient {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for suffix, body := range responses {
+			if strings.HasSuffix(r.URL.Path, suffix) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(body))
+				return
+			}
+		}
+		t.Logf("no response for the request: %s", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"description": "not supported"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	auth, err := core.NewBearerTokenAuthenticator("token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	session, err := ibmpisession.NewIBMPISession(&ibmpisession.IBMPIOptions{
+		Authenticator: auth,
+		URL:           server.URL,
+		UserAccount:   "abcd1234",
+		Zone:          "lon04",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client.NewWorkspacePVMClient(&resourcecontrollerv2.ResourceInstance{
+		GUID: ptr.To("ws-id"), Name: ptr.To("ws"), RegionID: ptr.To("lon04"),
+	}, session)
+}
+
+// workspaceResponses are the responses of a workspace with an instance and a DHCP server
+func workspaceResponses() map[string]string {
+	return map[string]string{
+		"/pvm-instances":     `{"pvmInstances": [{"pvmInstanceID": "vm-1", "serverName": "vm-1", "status": "ACTIVE"}]}`,
+		"/volumes":           `{"volumes": []}`,
+		"/networks":          `{"networks": []}`,
+		"/images":            `{"images": []}`,
+		"/services/dhcp":     `[{"id": "dhcp-1", "status": "ACTIVE"}]`,
+		"/cloud-connections": `{"cloudConnections": [{"cloudConnectionID": "cc-1", "name": "cc"}]}`,
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	tests := []struct {
+		name   string
+		remove string
+		want   map[string]int
+	}{
+		{
+			name: "all the resources",
+			want: map[string]int{KindInstance: 1, KindVolume: 0, KindNetwork: 0, KindImage: 0, KindDHCPServer: 1, KindCloudConnection: 1},
+		},
+		{
+			name:   "cloud connections fail on the PER workspace",
+			remove: "/cloud-connections",
+			want:   map[string]int{KindInstance: 1, KindVolume: 0, KindNetwork: 0, KindImage: 0, KindDHCPServer: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			responses := workspaceResponses()
+			delete(responses, tt.remove)
+			counts, err := Summarize(fakeWorkspace(t, responses))
+			if err != nil {
+				t.Fatalf("Summarize() returned error: %v", err)
+			}
+			got := map[string]int{}
+			for _, c := range counts {
+				got[c.Kind] = c.Count
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Summarize() = %v, want %v", got, tt.want)
+			}
+			for kind, count := range tt.want {
+				if got[kind] != count {
+					t.Errorf("Summarize() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestSummarizeFailure(t *testing.T) {
+	responses := workspaceResponses()
+	delete(responses, "/volumes")
+	if _, err := Summarize(fakeWorkspace(t, responses)); err == nil {
+		t.Errorf("Summarize() returned no error when the volumes can't be listed")
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lease manages the ephemeral workspaces leased for a limited time, the lease is stored in the user tags of
+// the workspace
+package lease
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"k8s.io/utils/ptr"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg/client"
+)
+
+// Prefixes of the user tags holding the lease
+const (
+	OwnerTag  = "pvsadm-lease-owner:"
+	ExpiryTag = "pvsadm-lease-expiry:"
+	PoolTag   = "pvsadm-lease-pool:"
+)
+
+var (
+	invalidTagChars = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+	nonceRegex      = regexp.MustCompile(`^[0-9a-f]{16}$`)
+)
+
+// Lease of the workspace, the workspace in the pool without the expiry is free to be leased
+type Lease struct {
+	Owner  string
+	Pool   string
+	Expiry time.Time
+	// Nonce is unique to every acquire, it is appended to the owner and the expiry tags to tell apart the leases of the
+	// same owner and expiry
+	Nonce string
+}
+
+// NewNonce returns a random nonce of the lease
+func NewNonce() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// Leased returns true if the workspace is leased
+func (l *Lease) Leased() bool {
+	return !l.Expiry.IsZero()
+}
+
+// Expired returns true if the lease is expired at the time
+func (l *Lease) Expired(now time.Time) bool {
+	return l.Leased() && !now.Before(l.Expiry)
+}
+
+// Tags returns the user tags of the lease
+func (l *Lease) Tags() []string {
+	var tags []string
+	if l.Pool != "" {
+		tags = append(tags, PoolTag+SanitizeTag(l.Pool))
+	}
+	if l.Leased() {
+		var nonce string
+		if l.Nonce != "" {
+			nonce = "." + l.Nonce
+		}
+		tags = append(tags, OwnerTag+SanitizeTag(l.Owner)+nonce, ExpiryTag+strconv.FormatInt(l.Expiry.Unix(), 10)+nonce)
+	}
+	return tags
+}
+
+// cutNonce splits the tag value into the value and the nonce appended to it
+func cutNonce(v string) (string, string) {
+	if i := strings.LastIndex(v, "."); i >= 0 && nonceRegex.MatchString(v[i+1:]) {
+		return v[:i], v[i+1:]
+	}
+	return v, ""
+}
+
+// Parse returns the lease from the user tags, nil if the workspace is neither leased nor in a pool
+func Parse(tags []string) (*Lease, error) {
+	l := &Lease{}
+	var found bool
+	for _, t := range tags {
+		switch {
+		case strings.HasPrefix(t, OwnerTag):
+			l.Owner, l.Nonce = cutNonce(strings.TrimPrefix(t, OwnerTag))
+		case strings.HasPrefix(t, PoolTag):
+			l.Pool = strings.TrimPrefix(t, PoolTag)
+		case strings.HasPrefix(t, ExpiryTag):
+			var expiry string
+			expiry, l.Nonce = cutNonce(strings.TrimPrefix(t, ExpiryTag))
+			sec, err := strconv.ParseInt(expiry, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid lease expiry tag %q", t)
+			}
+			l.Expiry = time.Unix(sec, 0).UTC()
+		default:
+			continue
+		}
+		found = true
+	}
+	if !found {
+		return nil, nil
+	}
+	return l, nil
+}
+
+// LeaseTags returns the tags of the lease owner and the expiry from the user tags, the pool tag is kept
+func LeaseTags(tags []string) []string {
+	var lease []string
+	for _, t := range tags {
+		if strings.HasPrefix(t, OwnerTag) || strings.HasPrefix(t, ExpiryTag) {
+			lease = append(lease, t)
+		}
+	}
+	return lease
+}
+
+// SanitizeTag replaces the characters not allowed in the tag value
+func SanitizeTag(s string) string {
+	s = strings.Trim(invalidTagChars.ReplaceAllString(strings.ToLower(s), "-"), "-")
+	if len(s) > 64 {
+		s = s[:64]
+	}
+	return s
+}
+
+// Workspace is the workspace with the lease
+type Workspace struct {
+	Instance resourcecontrollerv2.ResourceInstance
+	Tags     []string
+	Lease    *Lease
+}
+
+// Name of the workspace
+func (w *Workspace) Name() string {
+	return ptr.Deref(w.Instance.Name, "")
+}
+
+// ID of the workspace
+func (w *Workspace) ID() string {
+	return ptr.Deref(w.Instance.GUID, "")
+}
+
+// CRN of the workspace
+func (w *Workspace) CRN() string {
+	return ptr.Deref(w.Instance.CRN, "")
+}
+
+// HeldBy returns true if the workspace carries only the tags of the lease, a workspace leased concurrently carries
+// the tags of both the leases as the nonce tells them apart even when they share the owner and the expiry
+func (w *Workspace) HeldBy(l *Lease) bool {
+	if l.Nonce == "" {
+		return false
+	}
+	want := LeaseTags(l.Tags())
+	got := LeaseTags(w.Tags)
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range want {
+		if !slices.Contains(got, want[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// List returns the leased and the pooled workspaces in the account
+func List(c *client.Client) ([]*Workspace, error) {
+	instances, err := c.ListWorkspaceInstances()
+	if err != nil {
+		return nil, err
+	}
+	var workspaces []*Workspace
+	for _, i := range instances.Resources {
+		w, err := get(c, i)
+		if err != nil {
+			return nil, err
+		}
+		if w.Lease != nil {
+			workspaces = append(workspaces, w)
+		}
+	}
+	return workspaces, nil
+}
+
+// Get returns the workspace with the lease by the ID
+func Get(c *client.Client, id string) (*Workspace, error) {
+	i, err := c.GetServiceInstance(id)
+	if err != nil {
+		return nil, err
+	}
+	return get(c, *i)
+}
+
+func get(c *client.Client, i resourcecontrollerv2.ResourceInstance) (*Workspace, error) {
+	tags, err := c.ListTags(ptr.Deref(i.CRN, ""))
+	if err != nil {
+		return nil, err
+	}
+	l, err := Parse(tags)
+	if err != nil {
+		return nil, fmt.Errorf("workspace %s: %v", ptr.Deref(i.Name, ""), err)
+	}
+	return &Workspace{Instance: i, Tags: tags, Lease: l}, nil
+}
+
+// Set replaces the lease tags of the workspace with the lease
+func Set(c *client.Client, w *Workspace, l *Lease) error {
+	if err := c.DetachTags(w.CRN(), LeaseTags(w.Tags)...); err != nil {
+		return err
+	}
+	if err := c.AttachTags(w.CRN(), l.Tags()...); err != nil {
+		return err
+	}
+	w.Lease = l
+	w.Tags = l.Tags()
+	return nil
+}
+
+// Clear removes the owner and the expiry tags of the workspace, the workspace in the pool is free to be leased again
+func Clear(c *client.Client, w *Workspace) error {
+	if err := c.DetachTags(w.CRN(), LeaseTags(w.Tags)...); err != nil {
+		return err
+	}
+	if w.Lease != nil {
+		w.Lease = &Lease{Pool: w.Lease.Pool}
+		w.Tags = w.Lease.Tags()
+	}
+	return nil
+}
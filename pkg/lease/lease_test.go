@@ -0,0 +1,142 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lease
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	expiry := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		tags    []string
+		want    *Lease
+		wantErr bool
+	}{
+		{
+			name: "not leased",
+			tags: []string{"env:ci", "team:core"},
+		},
+		{
+			name: "free workspace in the pool",
+			tags: []string{"env:ci", "pvsadm-lease-pool:ci"},
+			want: &Lease{Pool: "ci"},
+		},
+		{
+			name: "leased workspace",
+			tags: []string{"pvsadm-lease-owner:job-42", "pvsadm-lease-expiry:1792411200", "pvsadm-lease-pool:ci"},
+			want: &Lease{Owner: "job-42", Pool: "ci", Expiry: expiry},
+		},
+		{
+			name: "leased workspace with the nonce",
+			tags: []string{"pvsadm-lease-owner:job-42.0123456789abcdef", "pvsadm-lease-expiry:1792411200.0123456789abcdef"},
+			want: &Lease{Owner: "job-42", Expiry: expiry, Nonce: "0123456789abcdef"},
+		},
+		{
+			name: "owner with the dot",
+			tags: []string{"pvsadm-lease-owner:build.42", "pvsadm-lease-expiry:1792411200"},
+			want: &Lease{Owner: "build.42", Expiry: expiry},
+		},
+		{
+			name:    "invalid expiry",
+			tags:    []string{"pvsadm-lease-expiry:tomorrow"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.tags)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLease(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	l := &Lease{Owner: "CI Job/42", Pool: "ci", Expiry: now.Add(time.Hour)}
+	want := []string{"pvsadm-lease-pool:ci", "pvsadm-lease-owner:ci-job-42", "pvsadm-lease-expiry:1792414800"}
+	if got := l.Tags(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Tags() = %v, want %v", got, want)
+	}
+	if l.Expired(now) || !l.Expired(now.Add(time.Hour)) {
+		t.Errorf("Expired() is wrong around the expiry %v", l.Expiry)
+	}
+	free := &Lease{Pool: "ci"}
+	if free.Leased() || free.Expired(now) {
+		t.Errorf("free workspace in the pool must not be leased or expired")
+	}
+	if got := LeaseTags(append(want, "env:ci")); !reflect.DeepEqual(got, want[1:]) {
+		t.Errorf("LeaseTags() = %v, want %v", got, want[1:])
+	}
+
+	l.Nonce = "0123456789abcdef"
+	want = []string{"pvsadm-lease-pool:ci", "pvsadm-lease-owner:ci-job-42.0123456789abcdef", "pvsadm-lease-expiry:1792414800.0123456789abcdef"}
+	if got := l.Tags(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Tags() = %v, want %v", got, want)
+	}
+	if got := LeaseTags(append(want, "env:ci")); !reflect.DeepEqual(got, want[1:]) {
+		t.Errorf("LeaseTags() = %v, want %v", got, want[1:])
+	}
+
+	w := &Workspace{Tags: append([]string{"env:ci"}, l.Tags()...)}
+	if !w.HeldBy(l) {
+		t.Errorf("HeldBy() = false, want true for the tags %v", w.Tags)
+	}
+	if w.HeldBy(&Lease{Owner: l.Owner, Pool: l.Pool, Expiry: l.Expiry}) {
+		t.Errorf("HeldBy() = true, want false for the lease without the nonce")
+	}
+	other := &Lease{Owner: "job-43", Pool: "ci", Expiry: now.Add(2 * time.Hour), Nonce: NewNonce()}
+	w.Tags = append(w.Tags, other.Tags()[1:]...)
+	if w.HeldBy(l) || w.HeldBy(other) {
+		t.Errorf("HeldBy() = true, want false for the concurrently leased tags %v", w.Tags)
+	}
+}
+
+func TestHeldBySameOwnerAndExpiry(t *testing.T) {
+	expiry := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	first := &Lease{Owner: "runner", Pool: "ci", Expiry: expiry, Nonce: NewNonce()}
+	second := &Lease{Owner: "runner", Pool: "ci", Expiry: expiry, Nonce: NewNonce()}
+	if first.Nonce == second.Nonce {
+		t.Fatalf("NewNonce() returned the same nonce twice: %s", first.Nonce)
+	}
+
+	// The owner and the expiry tags of both the acquirers don't collapse into one as they carry the nonce
+	tags := append([]string{}, first.Tags()...)
+	for _, tag := range second.Tags() {
+		if !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	w := &Workspace{Tags: tags}
+	if w.HeldBy(first) || w.HeldBy(second) {
+		t.Errorf("HeldBy() = true, want false for both the acquirers of the tags %v", w.Tags)
+	}
+
+	// The acquirer losing the race detaches its lease tags, the tags of the other lease are kept
+	lost := LeaseTags(second.Tags())
+	w.Tags = slices.DeleteFunc(w.Tags, func(tag string) bool { return slices.Contains(lost, tag) })
+	if !w.HeldBy(first) {
+		t.Errorf("HeldBy() = false, want true for the remaining lease in the tags %v", w.Tags)
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metrics writes the metrics in the Prometheus text exposition format
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ContentType of the Prometheus text exposition format
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// Labels of the sample
+type Labels map[string]string
+
+// Sample is a single value of the metric family
+type Sample struct {
+	Labels Labels
+	Value  float64
+}
+
+// Family is the group of the samples with the same metric name
+type Family struct {
+	Name    string
+	Help    string
+	Type    string
+	Samples []Sample
+}
+
+// NewGauge returns the gauge metric family
+func NewGauge(name, help string) *Family {
+	return &Family{Name: name, Help: help, Type: "gauge"}
+}
+
+// Add adds the sample with the labels
+func (f *Family) Add(value float64, labels Labels) {
+	f.Samples = append(f.Samples, Sample{Labels: labels, Value: value})
+}
+
+// Counts adds a sample for each key of the counts with the key set as the value of the label
+func (f *Family) Counts(counts map[string]int, label string, labels Labels) {
+	for k, v := range counts {
+		l := Labels{label: k}
+		for lk, lv := range labels {
+			l[lk] = lv
+		}
+		f.Add(float64(v), l)
+	}
+}
+
+// Write writes the metric families in the text exposition format, the samples are sorted by the labels to keep the
+// output stable
+func Write(w io.Writer, families []*Family) error {
+	bw := bufio.NewWriter(w)
+	for _, f := range families {
+		if f.Help != "" {
+			fmt.Fprintf(bw, "# HELP %s %s\n", f.Name, escapeHelp(f.Help))
+		}
+		if f.Type != "" {
+			fmt.Fprintf(bw, "# TYPE %s %s\n", f.Name, f.Type)
+		}
+		lines := make([]string, 0, len(f.Samples))
+		for _, s := range f.Samples {
+			lines = append(lines, f.Name+formatLabels(s.Labels)+" "+formatValue(s.Value))
+		}
+		sort.Strings(lines)
+		for _, l := range lines {
+			bw.WriteString(l + "\n")
+		}
+	}
+	return bw.Flush()
+}
+
+func formatLabels(labels Labels) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, k+`="`+escapeLabel(labels[k])+`"`)
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func formatValue(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+func escapeHelp(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(s)
+}
+
+func escapeLabel(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`).Replace(s)
+}
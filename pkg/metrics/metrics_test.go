@@ -0,0 +1,50 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+func TestWrite(t *testing.T) {
+	instances := NewGauge("pvsadm_instances", "Number of the instances\nby the status")
+	instances.Counts(map[string]int{"ACTIVE": 2, "ERROR": 1}, "status", Labels{"workspace": "ws"})
+	up := NewGauge("pvsadm_up", "")
+	up.Add(1, nil)
+	odd := NewGauge("pvsadm_odd", `Odd "values"`)
+	odd.Add(math.Inf(1), Labels{"name": "a\"b\\c\nd"})
+	odd.Add(0.5, Labels{"name": "e"})
+
+	var b bytes.Buffer
+	if err := Write(&b, []*Family{instances, up, odd}); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	want := `# HELP pvsadm_instances Number of the instances\nby the status
+# TYPE pvsadm_instances gauge
+pvsadm_instances{status="ACTIVE",workspace="ws"} 2
+pvsadm_instances{status="ERROR",workspace="ws"} 1
+# TYPE pvsadm_up gauge
+pvsadm_up 1
+# HELP pvsadm_odd Odd "values"
+# TYPE pvsadm_odd gauge
+pvsadm_odd{name="a\"b\\c\nd"} +Inf
+pvsadm_odd{name="e"} 0.5
+`
+	if got := b.String(); got != want {
+		t.Errorf("Write() =\n%s\nwant:\n%s", got, want)
+	}
+}
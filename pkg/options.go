@@ -19,20 +19,28 @@ import "time"
 var Options = &options{}
 
 type options struct {
-	WorkspaceID   string
-	APIKey        string
-	Environment   string
-	Region        string
-	Zone          string
-	DryRun        bool
-	Debug         bool
-	Since         time.Duration
-	Before        time.Duration
-	WorkspaceName string
-	NoPrompt      bool
-	IgnoreErrors  bool
-	AuditFile     string
-	Expr          string
+	WorkspaceID      string
+	APIKey           string
+	IAMToken         string
+	TrustedProfile   string
+	CRTokenFile      string
+	TokenCache       bool
+	AccountID        string
+	Environment      string
+	PrivateEndpoints bool
+	Region           string
+	Zone             string
+	DryRun           bool
+	Debug            bool
+	Since            time.Duration
+	Before           time.Duration
+	WorkspaceName    string
+	NoPrompt         bool
+	IgnoreErrors     bool
+	AuditFile        string
+	AuditMaxSize     int
+	Expr             string
+	ConfigFile       string
 }
 
 // Options for pvsadm image command
@@ -40,21 +48,38 @@ var ImageCMDOptions = &imageCMDOptions{}
 
 type imageCMDOptions struct {
 	//qcow2ova options
-	ImageDist           string
-	ImageName           string
-	ImageSize           uint64
-	TargetDiskSize      int64
-	ImageURL            string
-	OSPassword          string
-	PreflightSkip       []string
-	RHNUser             string
-	RHNPassword         string
-	TempDir             string
-	PrepTemplate        string
-	PrepTemplateDefault bool
-	CloudConfig         string
-	CloudConfigDefault  bool
-	OSPasswordSkip      bool
+	ImageDist             string
+	ImageName             string
+	ImageSize             uint64
+	TargetDiskSize        int64
+	ImageURL              string
+	ImageChecksum         string
+	ImageURLToken         string
+	ImageURLUser          string
+	ImageURLPassword      string
+	ImageProxy            string
+	ImageCacheDir         string
+	ImageCacheMaxSize     int64
+	NoImageCache          bool
+	OSPassword            string
+	OSPasswordFile        string
+	GeneratedPasswordFile string
+	PreflightSkip         []string
+	RHNUser               string
+	RHNPassword           string
+	RHNPasswordFile       string
+	RHNActivationKey      string
+	RHNActivationKeyFile  string
+	RHNOrgID              string
+	TempDir               string
+	PrepTemplate          string
+	PrepTemplateDefault   bool
+	Customization         string
+	CloudConfig           string
+	CloudConfigTemplate   string
+	CloudConfigDefault    bool
+	CloudConfigSet        []string
+	OSPasswordSkip        bool
 	//upload options
 	WorkspaceName string
 	Region        string
@@ -73,6 +98,35 @@ type imageCMDOptions struct {
 	Public          bool
 	Watch           bool
 	WatchTimeout    time.Duration
+	//info options
+	Output string
+	//verify options
+	SkipDiskChecks bool
+	//ova2qcow2 options
+	OutputFile    string
+	CompressQcow2 bool
+	//export options
+	ExportImage string
+	Download    bool
+	//capture options
+	CaptureVM          string
+	CaptureDestination string
+	CaptureVolumes     []string
+	//import-stock options
+	StockImageName string
+	ImportAll      bool
+	IncludeSAP     bool
+	IncludeVTL     bool
+	//promote options
+	FromWorkspace string
+	ToWorkspaces  []string
+	StorageClass  string
+	TargetBuckets map[string]string
+	TargetRegions map[string]string
 	//sync options
 	SpecYAML string
+	//cache options
+	CachePruneAll     bool
+	CachePruneMaxSize int64
+	CacheOlderThan    time.Duration
 }
@@ -24,22 +24,35 @@ import (
 const defaultExitCode = 1
 
 func RunCMD(cmd string, args ...string) (int, string, string) {
+	return RunCMDWithEnv(nil, cmd, args...)
+}
+
+// RunCMDWithEnv runs the command with the additional environment variables, the registered secrets are
+// masked in the output
+func RunCMDWithEnv(env []string, cmd string, args ...string) (int, string, string) {
 	var stdout, stderr bytes.Buffer
 	c := exec.Command(cmd, args...)
+	if len(env) > 0 {
+		c.Env = append(os.Environ(), env...)
+	}
 
-	c.Stdout = io.MultiWriter(os.Stdout, &stdout)
-	c.Stderr = io.MultiWriter(os.Stderr, &stderr)
-	if err := c.Run(); err != nil {
+	redactedStdout, redactedStderr := NewRedactWriter(os.Stdout), NewRedactWriter(os.Stderr)
+	c.Stdout = io.MultiWriter(redactedStdout, &stdout)
+	c.Stderr = io.MultiWriter(redactedStderr, &stderr)
+	err := c.Run()
+	_ = redactedStdout.Flush()
+	_ = redactedStderr.Flush()
+	if err != nil {
 		if exitError, ok := err.(*exec.ExitError); ok {
-			return exitError.ExitCode(), stdout.String(), stderr.String()
+			return exitError.ExitCode(), Redact(stdout.String()), Redact(stderr.String())
 		} else {
 			// This case is for macOS, exit code could get and stderr will be empty string
 			errString := stderr.String()
 			if errString == "" {
 				errString = err.Error()
 			}
-			return defaultExitCode, stdout.String(), errString
+			return defaultExitCode, Redact(stdout.String()), Redact(errString)
 		}
 	}
-	return 0, stdout.String(), stderr.String()
+	return 0, Redact(stdout.String()), Redact(stderr.String())
 }
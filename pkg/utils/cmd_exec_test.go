@@ -15,6 +15,8 @@
 package utils
 
 import (
+	"bytes"
+	"fmt"
 	"strings"
 	"testing"
 )
@@ -61,3 +63,49 @@ func TestRunCMD(t *testing.T) {
 		})
 	}
 }
+
+func TestRunCMDWithEnvRedact(t *testing.T) {
+	RegisterSecret("s3cr3t-value")
+	got, out, _ := RunCMDWithEnv([]string{"SECRET=s3cr3t-value"}, "sh", "-c", `echo "secret is ${SECRET}"`)
+	if got != 0 {
+		t.Fatalf("RunCMDWithEnv() got = %v, want 0", got)
+	}
+	if strings.TrimSuffix(out, "\n") != "secret is ******" {
+		t.Errorf("RunCMDWithEnv() out = %v, want the secret redacted", out)
+	}
+	if err := RedactError(fmt.Errorf("failed with s3cr3t-value")); err.Error() != "failed with ******" {
+		t.Errorf("RedactError() = %v, want the secret redacted", err)
+	}
+}
+
+func TestRedactFilter(t *testing.T) {
+	RegisterSecret("filter-secret")
+	f := RedactFilter{}
+	format, args := f.FilterF("password: %s, count: %d", []interface{}{"filter-secret", 2})
+	if got := fmt.Sprintf(format, args...); got != "password: ******, count: 2" {
+		t.Errorf("FilterF() = %v", got)
+	}
+	if got := fmt.Sprintln(f.Filter([]interface{}{"password:", "filter-secret", 2})...); got != "password: ****** 2\n" {
+		t.Errorf("Filter() = %v", got)
+	}
+}
+
+func TestRedactWriter(t *testing.T) {
+	RegisterSecret("split-secret")
+	var out bytes.Buffer
+	w := NewRedactWriter(&out)
+	for _, chunk := range []string{"token: spl", "it-secret\nprogress 10%\r", "last split-", "secret"} {
+		if _, err := w.Write([]byte(chunk)); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	if got := out.String(); got != "token: ******\nprogress 10%\r" {
+		t.Errorf("Write() out = %q, want the complete lines redacted", got)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	if got := out.String(); got != "token: ******\nprogress 10%\rlast ******" {
+		t.Errorf("Flush() out = %q, want the last line redacted", got)
+	}
+}
@@ -16,6 +16,7 @@ package utils
 
 const (
 	ServiceTypeCloudObjectStorage = "cloud-object-storage"
+	ServiceTypePowerVS            = "power-iaas"
 )
 
 const (
@@ -0,0 +1,79 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// Poster posts the request bodies to a webhook. The requests failed with the network errors and the 429 and 5xx
+// responses are retried with an exponential backoff.
+type Poster struct {
+	Client  *http.Client
+	Headers map[string]string
+	// Retries is the number of the retries of a failed request
+	Retries int
+	// Backoff is the delay before the first retry, doubled on every retry
+	Backoff time.Duration
+}
+
+// Post sends the body with the content type to the url, retrying the failed requests
+func (p *Poster) Post(url, contentType string, body []byte) error {
+	backoff := p.Backoff
+	for attempt := 0; ; attempt++ {
+		retry, err := p.post(url, contentType, body)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt >= p.Retries {
+			return err
+		}
+		klog.V(2).Infof("Retrying the webhook in %s, err: %v", backoff, err)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// post sends the body and reports whether the failed request is worth retrying
+func (p *Poster) post(url, contentType string, body []byte) (bool, error) {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	for k, v := range p.Headers {
+		req.Header.Set(k, v)
+	}
+	client := p.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500, fmt.Errorf("unexpected status: %s", resp.Status)
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPosterPost(t *testing.T) {
+	tests := []struct {
+		name         string
+		failures     int
+		status       int
+		wantErr      bool
+		wantRequests int
+	}{
+		{name: "delivered", wantRequests: 1},
+		{name: "retried on server error", failures: 2, status: http.StatusServiceUnavailable, wantRequests: 3},
+		{name: "retried on too many requests", failures: 1, status: http.StatusTooManyRequests, wantRequests: 2},
+		{name: "retries exhausted", failures: 10, status: http.StatusInternalServerError, wantErr: true, wantRequests: 4},
+		{name: "no retry on client error", failures: 10, status: http.StatusBadRequest, wantErr: true, wantRequests: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if r.Header.Get("Content-Type") != "application/json" || r.Header.Get("Authorization") != "Bearer token" {
+					t.Errorf("Post() sent the headers %v", r.Header)
+				}
+				if requests <= tt.failures {
+					w.WriteHeader(tt.status)
+				}
+			}))
+			defer srv.Close()
+			p := &Poster{Headers: map[string]string{"Authorization": "Bearer token"}, Retries: 3}
+			if err := p.Post(srv.URL, "application/json", []byte("[]")); (err != nil) != tt.wantErr {
+				t.Errorf("Post() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if requests != tt.wantRequests {
+				t.Errorf("Post() sent %d requests, want %d", requests, tt.wantRequests)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 IBM Corp
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+const redactedMask = "******"
+
+var (
+	secretsMu sync.RWMutex
+	secrets   []string
+)
+
+// RegisterSecret adds the secret to be masked by the Redact functions
+func RegisterSecret(secret string) {
+	if strings.TrimSpace(secret) == "" {
+		return
+	}
+	secretsMu.Lock()
+	defer secretsMu.Unlock()
+	for _, s := range secrets {
+		if s == secret {
+			return
+		}
+	}
+	secrets = append(secrets, secret)
+}
+
+// Redact masks the registered secrets in the string
+func Redact(s string) string {
+	secretsMu.RLock()
+	defer secretsMu.RUnlock()
+	for _, secret := range secrets {
+		s = strings.ReplaceAll(s, secret, redactedMask)
+	}
+	return s
+}
+
+// RedactError returns the error with the registered secrets masked in the message
+func RedactError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if msg := Redact(err.Error()); msg != err.Error() {
+		return errors.New(msg)
+	}
+	return err
+}
+
+// RedactWriter masks the registered secrets before writing to the underlying writer, the output is buffered by line
+// to mask the secrets split across the writes. Flush writes the incomplete last line.
+type RedactWriter struct {
+	w   io.Writer
+	buf []byte
+}
+
+// NewRedactWriter returns a writer which masks the registered secrets before writing to w
+func NewRedactWriter(w io.Writer) *RedactWriter {
+	return &RedactWriter{w: w}
+}
+
+func (r *RedactWriter) Write(p []byte) (int, error) {
+	r.buf = append(r.buf, p...)
+	// The carriage return ends the line as well to not hold back the progress output
+	if i := bytes.LastIndexAny(r.buf, "\r\n"); i >= 0 {
+		line := r.buf[:i+1]
+		r.buf = r.buf[i+1:]
+		if _, err := io.WriteString(r.w, Redact(string(line))); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush writes the buffered incomplete line
+func (r *RedactWriter) Flush() error {
+	if len(r.buf) == 0 {
+		return nil
+	}
+	_, err := io.WriteString(r.w, Redact(string(r.buf)))
+	r.buf = nil
+	return err
+}
+
+// RedactFilter is the klog.LogFilter masking the registered secrets, installed with klog.SetLogFilter
+type RedactFilter struct{}
+
+func (RedactFilter) Filter(args []interface{}) []interface{} {
+	out := make([]interface{}, len(args))
+	for i, arg := range args {
+		out[i] = redactValue(arg)
+	}
+	return out
+}
+
+func (RedactFilter) FilterF(format string, args []interface{}) (string, []interface{}) {
+	return "%s", []interface{}{Redact(fmt.Sprintf(format, args...))}
+}
+
+func (f RedactFilter) FilterS(msg string, keysAndValues []interface{}) (string, []interface{}) {
+	return Redact(msg), f.Filter(keysAndValues)
+}
+
+// redactValue masks the secrets in the string values, other types are returned as is
+func redactValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case string:
+		return Redact(t)
+	case error:
+		return RedactError(t)
+	case fmt.Stringer:
+		return Redact(t.String())
+	}
+	return v
+}
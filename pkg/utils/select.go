@@ -16,6 +16,7 @@ package utils
 
 import (
 	"errors"
+	"os"
 
 	"k8s.io/klog/v2"
 
@@ -38,6 +39,43 @@ func SelectItem(msg string, instances []string) (string, error) {
 	return choice, nil
 }
 
+// Option is a choice shown by SelectOption, the Label is displayed and the Value is returned
+type Option struct {
+	Label string
+	Value string
+}
+
+// SelectOption prompts to pick one of the options, the list can be filtered by typing and the
+// option matching the selected value is highlighted initially
+func SelectOption(msg string, options []Option, selected string) (string, error) {
+	opts := make([]huh.Option[string], 0, len(options))
+	for _, o := range options {
+		opts = append(opts, huh.NewOption(o.Label, o.Value).Selected(o.Value == selected))
+	}
+	choice := selected
+	err :=
+		huh.NewForm(
+			huh.NewGroup(
+				huh.NewSelect[string]().Title(msg).
+					Options(opts...).Filtering(true).Value(&choice))).Run()
+	if err != nil {
+		klog.Errorf("couldn't process the inputs: %v", err)
+		return "", err
+	}
+	return choice, nil
+}
+
+// IsInteractive reports if both the stdin and stdout are attached to a terminal
+func IsInteractive() bool {
+	for _, f := range []*os.File{os.Stdin, os.Stdout} {
+		fi, err := f.Stat()
+		if err != nil || fi.Mode()&os.ModeCharDevice == 0 {
+			return false
+		}
+	}
+	return true
+}
+
 func AskConfirmation(message string) bool {
 	var confirm bool
 	err :=
@@ -16,10 +16,13 @@ package utils
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 	"time"
 
 	"github.com/briandowns/spinner"
+
+	"github.com/ppc64le-cloud/pvsadm/pkg"
 )
 
 func FormatProcessor(proc *float64) string {
@@ -30,6 +33,26 @@ func FormatMemory(memory *float64) string {
 	return strconv.FormatFloat(*memory, 'f', -1, 64)
 }
 
+// FormatBytes formats the bytes to a human-readable string
+func FormatBytes(size int64) string {
+	const (
+		KB = 1024
+		MB = KB * 1024
+		GB = MB * 1024
+	)
+
+	switch {
+	case size >= GB:
+		return fmt.Sprintf("%.2f GB", float64(size)/GB)
+	case size >= MB:
+		return fmt.Sprintf("%.2f MB", float64(size)/MB)
+	case size >= KB:
+		return fmt.Sprintf("%.2f KB", float64(size)/KB)
+	default:
+		return fmt.Sprintf("%d Bytes", size)
+	}
+}
+
 func Contains(s []string, e string) bool {
 	for _, a := range s {
 		if a == e {
@@ -39,10 +62,20 @@ func Contains(s []string, e string) bool {
 	return false
 }
 
-// Ensure that either the workspaceID or the workspaceName is set, along with the API Key.
-func EnsurePrerequisitesAreSet(apiKey, workspaceID, workspaceName string) error {
-	if apiKey == "" {
-		return fmt.Errorf("api-key can't be empty, pass the token via --api-key or set IBMCLOUD_APIKEY environment variable")
+// EnsureCredentialsAreSet ensures that the API key, the IAM token or the trusted profile is set, or the
+// authentication is configured via the IBMCLOUD_AUTH_TYPE environment variable.
+func EnsureCredentialsAreSet() error {
+	opt := pkg.Options
+	if opt.APIKey != "" || opt.IAMToken != "" || opt.TrustedProfile != "" || os.Getenv("IBMCLOUD_AUTH_TYPE") != "" {
+		return nil
+	}
+	return fmt.Errorf("credentials can't be empty, pass the API key via --api-key or set IBMCLOUD_APIKEY environment variable, or pass --iam-token or --trusted-profile")
+}
+
+// Ensure that either the workspaceID or the workspaceName is set, along with the credentials.
+func EnsurePrerequisitesAreSet(workspaceID, workspaceName string) error {
+	if err := EnsureCredentialsAreSet(); err != nil {
+		return err
 	}
 
 	if workspaceID == "" && workspaceName == "" {